@@ -1,12 +1,16 @@
 package main
 
 import (
+	"time"
+
 	"github.com/automaticserver/lxe/cli"
 	"github.com/automaticserver/lxe/cri"
+	"github.com/automaticserver/lxe/lxf"
 	"github.com/automaticserver/lxe/network"
 	"github.com/dionysius/errand"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -30,6 +34,20 @@ func init() {
 	pflags.StringP("socket", "s", "/run/lxe.sock", "Path of the socket where it should provide the runtime and image service to kubelet.")
 	pflags.StringP("lxd-socket", "l", "", "Path of the socket where LXD provides it's API. (guessed by default)")
 	pflags.StringP("lxd-remote-config", "r", "", "Path to the LXD remote config. (guessed by default)")
+	pflags.StringP("lxd-remote", "", "", "Connect to LXD over https instead of --lxd-socket. Either the name of a remote in the LXD remote config, whose client certificate is used, or a https URL.")
+	pflags.StringP("lxd-tls-client-cert", "", "", "Path to the client certificate to authenticate at --lxd-remote. (taken from the LXD remote config by default)")
+	pflags.StringP("lxd-tls-client-key", "", "", "Path to the client key to authenticate at --lxd-remote. (taken from the LXD remote config by default)")
+	pflags.StringP("lxd-tls-ca", "", "", "Path to the CA certificate to validate the server certificate of --lxd-remote against.")
+	pflags.StringP("lxd-project", "", "", "Manage all containers, profiles and images in this LXD project, which is created if needed. Objects created by LXE in the default project are moved into it. Uses the default project if empty.")
+	pflags.BoolP("lxd-cache", "", true, "Keep sandboxes and containers in memory, updated by the lifecycle events of LXD, instead of asking LXD on every call.")
+	pflags.DurationP("lxd-cache-resync", "", 5*time.Minute, "Interval in which the cache is reloaded from LXD to recover from missed events. Disabled if 0.")
+	pflags.DurationP("lxd-timeout-create", "", 5*time.Minute, "Maximum time to wait for LXD to create a container. Unlimited if 0.")
+	pflags.DurationP("lxd-timeout-start", "", 2*time.Minute, "Maximum time to wait for LXD to start a container. Unlimited if 0.")
+	pflags.DurationP("lxd-timeout-stop", "", time.Minute, "Maximum time to wait for LXD to stop a container, in addition to the grace period of the stop request. Unlimited if 0.")
+	pflags.DurationP("lxd-timeout-update", "", time.Minute, "Maximum time to wait for LXD to update a container. Unlimited if 0.")
+	pflags.DurationP("lxd-timeout-delete", "", 2*time.Minute, "Maximum time to wait for LXD to delete a container or image. Unlimited if 0.")
+	pflags.DurationP("lxd-timeout-move", "", 10*time.Minute, "Maximum time to wait for LXD to move a container into --lxd-project. Unlimited if 0.")
+	pflags.DurationP("lxd-timeout-image", "", 30*time.Minute, "Maximum time to wait for LXD to pull or create an image. Unlimited if 0.")
 	pflags.StringP("lxd-image-remote", "", "local", "Use this remote if ImageSpec doesn't provide an explicit remote.")
 	pflags.StringSliceP("lxd-profiles", "p", []string{"default"}, "Set these additional profiles when creating containers.")
 	pflags.StringP("streaming-bindaddr", "", "localhost:44124", "Listen address for the streaming service. Be careful from where this service can be accessed from as it allows to run exec commands on the containers! Format: [IP]:Port.")
@@ -45,14 +63,80 @@ func init() {
 	pflags.StringP("cni-output-file-path", "", "stderr", "Path to output file. Only required if --cni-output-target is set to file.")
 	pflags.BoolP("critest", "", false, "Enable critest mode to be used with cri-tools' critest since LXE cannot use OCI images. Automatically creates some images and changes a few image names before handling the request. Read the log for arguments to use cri-tools' critest.")
 
+	pflags.DurationP("image-gc-interval", "", 0, "Interval in which dangling lxe image aliases and unused CRI images are searched for. Disabled if 0.")
+	pflags.DurationP("image-gc-min-age", "", time.Hour, "Minimum time since an image was pulled or last used before it is considered unused.")
+	pflags.BoolP("image-gc-cleanup", "", false, "Remove the found dangling image aliases and unused images, otherwise they are only reported. Pinned images and images in use are never removed.")
+
+	pflags.DurationP("gc-interval", "", 0, "Interval in which sandboxes without containers, containers without sandbox, CRI objects in an outdated schema and network leftovers of removed pods are searched for and removed. Disabled if 0.")
+	pflags.DurationP("gc-grace-period", "", time.Hour, "Minimum age of what's found by the garbage collector before it is removed.")
+	pflags.BoolP("gc-dry-run", "", false, "Only report what the garbage collector finds instead of removing it.")
+
 	rootCmd.RunE = rootCmdRunE
+
+	rootCmd.AddCommand(imageGCCmd)
+}
+
+var imageGCCmd = &cobra.Command{
+	Use:   "image-gc",
+	Short: "Search for dangling lxe image aliases and unused CRI images once and report them as yaml",
+	Long:  "Search for dangling lxe image aliases and unused CRI images once and report them as yaml. They are only removed if --image-gc-cleanup is set.",
+	Args:  cobra.NoArgs,
+	RunE:  imageGCCmdRunE,
+}
+
+func imageGCCmdRunE(cmd *cobra.Command, args []string) error {
+	conf, err := newConfig()
+	if err != nil {
+		return err
+	}
+
+	report, err := cri.ReconcileImages(cmd.Context(), conf)
+	if report != nil {
+		b, yerr := yaml.Marshal(report)
+		if yerr != nil {
+			return errand.Append(err, yerr)
+		}
+
+		_, yerr = cmd.OutOrStdout().Write(b)
+		err = errand.Append(err, yerr)
+	}
+
+	return err
 }
 
 func rootCmdRunE(cmd *cobra.Command, args []string) error {
+	conf, err := newConfig()
+	if err != nil {
+		return err
+	}
+
+	criServer := cri.NewServer(conf)
+
+	go func() {
+		err := errand.Append(nil, criServer.Serve())
+		if err != nil {
+			err = errand.Append(err, criServer.Stop())
+			log.WithError(err).Fatal("unable to start CRI server")
+		}
+	}()
+
+	// run forever
+	select {}
+}
+
+// newConfig creates the cri config from the loaded configuration
+func newConfig() (*cri.Config, error) {
 	conf := &cri.Config{
 		UnixSocket:           venom.GetString("socket"),
 		LXDSocket:            venom.GetString("lxd-socket"),
 		LXDRemoteConfig:      venom.GetString("lxd-remote-config"),
+		LXDRemote:            venom.GetString("lxd-remote"),
+		LXDTLSClientCert:     venom.GetString("lxd-tls-client-cert"),
+		LXDTLSClientKey:      venom.GetString("lxd-tls-client-key"),
+		LXDTLSCA:             venom.GetString("lxd-tls-ca"),
+		LXDProject:           venom.GetString("lxd-project"),
+		LXDCache:             venom.GetBool("lxd-cache"),
+		LXDCacheResync:       venom.GetDuration("lxd-cache-resync"),
 		LXDImageRemote:       venom.GetString("lxd-image-remote"),
 		LXDProfiles:          venom.GetStringSlice("lxd-profiles"),
 		LXEStreamingBindAddr: venom.GetString("streaming-bindaddr"),
@@ -66,18 +150,49 @@ func rootCmdRunE(cmd *cobra.Command, args []string) error {
 		CNIOutputTarget:      venom.GetString("cni-output-target"),
 		CNIOutputFile:        venom.GetString("cni-output-file-path"),
 		CRITest:              venom.GetBool("critest"),
+		ImageGCInterval:      venom.GetDuration("image-gc-interval"),
+		ImageGCMinAge:        venom.GetDuration("image-gc-min-age"),
+		ImageGCCleanup:       venom.GetBool("image-gc-cleanup"),
+		GCInterval:           venom.GetDuration("gc-interval"),
+		GCGracePeriod:        venom.GetDuration("gc-grace-period"),
+		GCDryRun:             venom.GetBool("gc-dry-run"),
 	}
 
-	criServer := cri.NewServer(conf)
+	conf.LXDOperationTimeouts = lxf.OperationTimeouts{
+		Create: venom.GetDuration("lxd-timeout-create"),
+		Start:  venom.GetDuration("lxd-timeout-start"),
+		Stop:   venom.GetDuration("lxd-timeout-stop"),
+		Update: venom.GetDuration("lxd-timeout-update"),
+		Delete: venom.GetDuration("lxd-timeout-delete"),
+		Move:   venom.GetDuration("lxd-timeout-move"),
+		Image:  venom.GetDuration("lxd-timeout-image"),
+	}
 
-	go func() {
-		err := errand.Append(nil, criServer.Serve())
-		if err != nil {
-			err = errand.Append(err, criServer.Stop())
-			log.WithError(err).Fatal("unable to start CRI server")
-		}
-	}()
+	// structured options can only be provided by the config file
+	err := venom.UnmarshalKey("image-rewrites", &conf.ImageRewriteRules)
+	if err != nil {
+		return nil, err
+	}
 
-	// run forever
-	select {}
+	err = venom.UnmarshalKey("image-policy", &conf.ImagePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venom.UnmarshalKey("lxd-namespace-projects", &conf.LXDNamespaceProjects)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venom.UnmarshalKey("runtime-handlers", &conf.RuntimeHandlers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venom.UnmarshalKey("runtime-annotations", &conf.AnnotationAllowlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
 }
@@ -0,0 +1,126 @@
+package cri
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/automaticserver/lxe/lxf"
+)
+
+const (
+	// annotationProfiles requests additional profiles, separated by comma
+	annotationProfiles = "profiles"
+	// annotationConfig is the prefix of the annotations setting a config key
+	annotationConfig = "config."
+	// annotationJob forces whether a container runs its command to completion, which is otherwise decided by whether
+	// a command is given. It's always allowed.
+	annotationJob = "job"
+)
+
+// AnnotationAllowlist controls which profiles and config keys pods and containers can request with the annotations
+// `lxe.automaticserver.io/profiles` and `lxe.automaticserver.io/config.<key>`. Config keys managed by LXE can never
+// be set.
+type AnnotationAllowlist struct {
+	// Profiles which can be requested, a trailing "*" matches any suffix
+	Profiles []string
+	// Config keys which can be set, a trailing "*" matches any suffix
+	Config []string
+}
+
+// annotationOptions are the profiles and config requested by annotations
+type annotationOptions struct {
+	Profiles []string
+	Config   map[string]string
+	Job      *bool
+}
+
+// parseAnnotations returns the options requested by the annotations of LXE. An annotation is accepted if the handler
+// allows it, the requested profiles and config keys are also accepted if they are in the allowlist.
+func (a AnnotationAllowlist) parseAnnotations(handler RuntimeHandler, annotations map[string]string) (annotationOptions, error) { // nolint: cyclop
+	opts := annotationOptions{Config: map[string]string{}}
+
+	for k, v := range annotations {
+		if !strings.HasPrefix(k, annotationPrefix) {
+			continue
+		}
+
+		name := strings.TrimPrefix(k, annotationPrefix)
+		byHandler := handler.allowsAnnotation(name)
+
+		switch {
+		case name == annotationProfiles:
+			for _, profile := range strings.Split(v, ",") {
+				profile = strings.TrimSpace(profile)
+				if profile == "" {
+					continue
+				}
+
+				if !byHandler && !matchesPattern(a.Profiles, profile) {
+					return annotationOptions{}, fmt.Errorf("%w: %s: profile %s", ErrAnnotationRejected, k, profile)
+				}
+
+				opts.Profiles = appendUnique(opts.Profiles, profile)
+			}
+		case name == annotationJob:
+			job, err := strconv.ParseBool(v)
+			if err != nil {
+				return annotationOptions{}, fmt.Errorf("%w: %s: %v", ErrAnnotationRejected, k, err)
+			}
+
+			opts.Job = &job
+		case strings.HasPrefix(name, annotationConfig):
+			key := strings.TrimPrefix(name, annotationConfig)
+			if key == "" || lxf.IsReservedContainerConfig(key) {
+				return annotationOptions{}, fmt.Errorf("%w: %s: config key is reserved", ErrAnnotationRejected, k)
+			}
+
+			if !byHandler && !matchesPattern(a.Config, key) {
+				return annotationOptions{}, fmt.Errorf("%w: %s", ErrAnnotationRejected, k)
+			}
+
+			opts.Config[key] = v
+		default:
+			if !byHandler {
+				return annotationOptions{}, fmt.Errorf("%w: %s", ErrAnnotationRejected, k)
+			}
+		}
+	}
+
+	return opts, nil
+}
+
+// containerProfiles returns the additional profiles of a container: the global ones, then the ones of the handler, the
+// pod and the container. Each profile is only set once.
+func containerProfiles(global []string, handler RuntimeHandler, pod, container annotationOptions) []string {
+	profiles := []string{}
+
+	for _, list := range [][]string{handler.profiles(global), pod.Profiles, container.Profiles} {
+		for _, profile := range list {
+			profiles = appendUnique(profiles, profile)
+		}
+	}
+
+	return profiles
+}
+
+// apply the config of the annotations to the container to be created
+func (o annotationOptions) apply(c *lxf.Container) {
+	for k, v := range o.Config {
+		c.Config[k] = v
+	}
+
+	if o.Job != nil {
+		c.Job = *o.Job
+	}
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+
+	return append(list, value)
+}
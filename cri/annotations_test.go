@@ -0,0 +1,74 @@
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotationAllowlist_parseAnnotations(t *testing.T) {
+	t.Parallel()
+
+	allowlist := AnnotationAllowlist{
+		Profiles: []string{"gpu", "team-*"},
+		Config:   []string{"limits.*", "security.nesting"},
+	}
+	handler := RuntimeHandler{Annotations: []string{"lxe.automaticserver.io/config.boot.*"}}
+	noJob := false
+
+	tests := []struct {
+		name        string
+		handler     RuntimeHandler
+		annotations map[string]string
+		exp         annotationOptions
+		wantErr     bool
+	}{
+		{"none", RuntimeHandler{}, nil, annotationOptions{Config: map[string]string{}}, false},
+		{"foreign", RuntimeHandler{}, map[string]string{"example.com/foo": "bar"}, annotationOptions{Config: map[string]string{}}, false},
+		{
+			"profiles", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/profiles": "gpu, team-a,gpu"},
+			annotationOptions{Profiles: []string{"gpu", "team-a"}, Config: map[string]string{}}, false,
+		},
+		{"profile rejected", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/profiles": "gpu,admin"}, annotationOptions{}, true},
+		{
+			"config", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/config.limits.cpu": "2", "lxe.automaticserver.io/config.security.nesting": "true"},
+			annotationOptions{Config: map[string]string{"limits.cpu": "2", "security.nesting": "true"}}, false,
+		},
+		{"config rejected", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/config.security.idmap.isolated": "true"}, annotationOptions{}, true},
+		{
+			"config allowed by handler", handler, map[string]string{"lxe.automaticserver.io/config.boot.autostart": "true"},
+			annotationOptions{Config: map[string]string{"boot.autostart": "true"}}, false,
+		},
+		{"reserved key", RuntimeHandler{Annotations: []string{"*"}}, map[string]string{"lxe.automaticserver.io/config.security.privileged": "true"}, annotationOptions{}, true},
+		{"reserved prefix", RuntimeHandler{Annotations: []string{"*"}}, map[string]string{"lxe.automaticserver.io/config.environment.FOO": "bar"}, annotationOptions{}, true},
+		{"job", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/job": "false"}, annotationOptions{Config: map[string]string{}, Job: &noJob}, false},
+		{"job invalid", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/job": "maybe"}, annotationOptions{}, true},
+		{"unknown", RuntimeHandler{}, map[string]string{"lxe.automaticserver.io/foo": "bar"}, annotationOptions{}, true},
+		{"unknown allowed by handler", RuntimeHandler{Annotations: []string{"foo"}}, map[string]string{"lxe.automaticserver.io/foo": "bar"}, annotationOptions{Config: map[string]string{}}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := allowlist.parseAnnotations(tt.handler, tt.annotations)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrAnnotationRejected)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.exp, opts)
+			}
+		})
+	}
+}
+
+func Test_containerProfiles(t *testing.T) {
+	t.Parallel()
+
+	handler := RuntimeHandler{Profiles: []string{"vm"}}
+	pod := annotationOptions{Profiles: []string{"gpu", "default"}}
+	container := annotationOptions{Profiles: []string{"team-a", "gpu"}}
+
+	assert.Equal(t, []string{"default", "vm", "gpu", "team-a"}, containerProfiles([]string{"default"}, handler, pod, container))
+}
@@ -1,5 +1,11 @@
 package cri
 
+import (
+	"time"
+
+	"github.com/automaticserver/lxe/lxf"
+)
+
 // Domain of the daemon
 const Domain = "lxe"
 
@@ -11,10 +17,33 @@ type Config struct {
 	LXDSocket string
 	// LXDRemoteConfig file path where lxd remote settings are stored
 	LXDRemoteConfig string
+	// LXDRemote is the name of a remote in the remote config or a https URL to reach LXD under instead of LXDSocket
+	LXDRemote string
+	// LXDTLSClientCert file path of the client certificate to authenticate at LXDRemote
+	LXDTLSClientCert string
+	// LXDTLSClientKey file path of the client key to authenticate at LXDRemote
+	LXDTLSClientKey string
+	// LXDTLSCA file path of the CA certificate to validate LXDRemote against
+	LXDTLSCA string
+	// LXDProject all objects are managed in, uses the default project if empty
+	LXDProject string
+	// LXDNamespaceProjects places the objects of each kubernetes namespace in its own project, can't be combined with
+	// LXDProject
+	LXDNamespaceProjects lxf.NamespaceProjects
+	// LXDCache serves sandboxes and containers from memory instead of asking LXD on every call
+	LXDCache bool
+	// LXDCacheResync is the interval the cache is reloaded from LXD, disabled if 0
+	LXDCacheResync time.Duration
+	// LXDOperationTimeouts limit how long is waited for the operations of LXD
+	LXDOperationTimeouts lxf.OperationTimeouts
 	// LXDImageRemote to use by default when ImageSpec doesn't provide an explicit remote
 	LXDImageRemote string
 	// LXDProfiles which all cri containers inherit
 	LXDProfiles []string
+	// RuntimeHandlers selectable by the runtime class of a pod
+	RuntimeHandlers RuntimeHandlers
+	// AnnotationAllowlist of the profiles and config keys pods and containers can request with annotations
+	AnnotationAllowlist AnnotationAllowlist
 	// LXDBridgeName is the name of the bridge to create and use
 	LXDBridgeName string
 	// LXDBridgeDHCPRange to configure for bridge if NetworkPlugin is default
@@ -37,4 +66,20 @@ type Config struct {
 	CNIOutputFile string
 	// CRITest mode that enables rewriting of requested images as cri-tools only refer to OCI-images
 	CRITest bool
+	// ImageRewriteRules to map requested images to other images or remotes
+	ImageRewriteRules lxf.ImageRewriteRules
+	// ImagePolicy restricts which images can be pulled and used
+	ImagePolicy ImagePolicy
+	// ImageGCInterval is the interval images are reconciled in, disabled if zero
+	ImageGCInterval time.Duration
+	// ImageGCMinAge an image must have before it is considered unused
+	ImageGCMinAge time.Duration
+	// ImageGCCleanup removes found dangling aliases and unused images, otherwise they are only reported
+	ImageGCCleanup bool
+	// GCInterval is the interval orphaned sandboxes, containers and network leftovers are collected in, disabled if zero
+	GCInterval time.Duration
+	// GCGracePeriod is the age an orphan must have before it is collected
+	GCGracePeriod time.Duration
+	// GCDryRun only reports the orphans found instead of removing them
+	GCDryRun bool
 }
@@ -1,8 +1,11 @@
 package cri
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/automaticserver/lxe/lxf"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 )
@@ -27,8 +30,31 @@ func (e AnnotatedError) String() string {
 	return fmt.Sprintf("%s: %v", e.Err, e.Log.Data)
 }
 
+// AnnErr annotates the error. If the code is codes.Unknown, it's derived from the error.
 func AnnErr(log *logrus.Entry, code codes.Code, err error, msg string) error {
-	return AnnotatedError{log, code, err, msg}
+	return AnnotatedError{log, errCode(code, err), err, msg}
+}
+
+// errCode derives the grpc code from the typed errors of lxf and the context, if the code is codes.Unknown
+func errCode(code codes.Code, err error) codes.Code {
+	if code != codes.Unknown {
+		return code
+	}
+
+	switch {
+	case lxf.IsNotFoundError(err):
+		return codes.NotFound
+	case errors.Is(err, lxf.ErrAlreadyRunning), errors.Is(err, lxf.ErrAlreadyStopped):
+		return codes.FailedPrecondition
+	case lxf.IsConflictError(err):
+		return codes.Aborted
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	}
+
+	return code
 }
 
 // Some errors should not be logged, so we can differentiate that by type
@@ -37,5 +63,5 @@ type SilentError struct {
 }
 
 func SilErr(log *logrus.Entry, code codes.Code, err error, msg string) error {
-	return SilentError{AnnotatedError{log, code, err, msg}}
+	return SilentError{AnnotatedError{log, errCode(code, err), err, msg}}
 }
@@ -0,0 +1,44 @@
+package cri
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func Test_errCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		code codes.Code
+		err  error
+		want codes.Code
+	}{
+		{"explicit code", codes.PermissionDenied, lxf.ErrNotFound, codes.PermissionDenied},
+		{"not found", codes.Unknown, fmt.Errorf("get: %w", lxf.ErrNotFound), codes.NotFound},
+		{"lxd not found", codes.Unknown, api.StatusErrorf(http.StatusNotFound, "not found"), codes.NotFound},
+		{"already running", codes.Unknown, lxf.ErrAlreadyRunning, codes.FailedPrecondition},
+		{"already stopped", codes.Unknown, lxf.ErrAlreadyStopped, codes.FailedPrecondition},
+		{"conflict", codes.Unknown, api.StatusErrorf(http.StatusConflict, "already exists"), codes.Aborted},
+		{"deadline", codes.Unknown, context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"canceled", codes.Unknown, context.Canceled, codes.Canceled},
+		{"untyped", codes.Unknown, errors.New("failed"), codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, errCode(tt.code, tt.err))
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package cri
 
 import (
-	"strings"
+	"encoding/json"
 	"time"
 
 	"github.com/automaticserver/lxe/lxf"
@@ -18,6 +18,8 @@ type ImageServer struct {
 	criConfig     *Config
 	runtimeRemote string
 	lxf           lxf.Client
+	policy        *imagePolicy
+	handlers      *podHandlers
 }
 
 // NewImageServer returns a new ImageServer backed by LXD
@@ -29,6 +31,8 @@ func NewImageServer(s *RuntimeServer, lxf lxf.Client) (*ImageServer, error) {
 		lxdConfig: s.lxdConfig,
 		criConfig: s.criConfig,
 		lxf:       lxf,
+		policy:    s.policy,
+		handlers:  s.handlers,
 	}
 	// apply default image remote
 	i.runtimeRemote = i.lxdConfig.DefaultRemote
@@ -49,28 +53,18 @@ func (s ImageServer) ListImages(ctx context.Context, req *rtApi.ListImagesReques
 
 	response := &rtApi.ListImagesResponse{}
 
-	imglist, err := s.lxf.ListImages(req.GetFilter().GetImage().GetImage())
+	filter := req.GetFilter().GetImage().GetImage()
+	if filter != "" {
+		filter = convertDockerImageNameToLXD(filter)
+	}
+
+	imglist, err := s.lxf.ListImages(ctx, filter)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "Unable to list images")
 	}
 
 	for _, imgInfo := range imglist {
-		rspImage := &rtApi.Image{
-			Id:          imgInfo.Hash,
-			Size_:       uint64(imgInfo.Size),
-			RepoDigests: []string{},
-			RepoTags:    []string{},
-		}
-
-		for _, a := range imgInfo.Aliases {
-			if strings.Contains(a, "@sha256:") {
-				rspImage.RepoDigests = append(rspImage.RepoDigests, convertLXEAliasNameToDocker(a))
-			} else {
-				rspImage.RepoTags = append(rspImage.RepoTags, convertLXEAliasNameToDocker(a))
-			}
-		}
-
-		response.Images = append(response.Images, rspImage)
+		response.Images = append(response.Images, toCRIImage(imgInfo))
 	}
 
 	return response, nil
@@ -83,7 +77,7 @@ func (s ImageServer) ImageStatus(ctx context.Context, req *rtApi.ImageStatusRequ
 	image := convertDockerImageNameToLXD(req.GetImage().GetImage())
 	log := log.WithContext(ctx).WithField("image", image)
 
-	imgInfo, err := s.lxf.GetImage(image)
+	imgInfo, err := s.lxf.GetImage(ctx, image)
 	if err != nil {
 		// If the image can't be found, return no error with empty result
 		if lxf.IsNotFoundError(err) {
@@ -93,22 +87,18 @@ func (s ImageServer) ImageStatus(ctx context.Context, req *rtApi.ImageStatusRequ
 		return nil, AnnErr(log, codes.Unknown, err, "failed to get image status")
 	}
 
-	rspImage := &rtApi.Image{
-		Id:          imgInfo.Hash,
-		Size_:       uint64(imgInfo.Size),
-		RepoDigests: []string{},
-		RepoTags:    []string{},
-	}
+	response := &rtApi.ImageStatusResponse{Image: toCRIImage(imgInfo)}
 
-	for _, a := range imgInfo.Aliases {
-		if strings.Contains(a, "@sha256") {
-			rspImage.RepoDigests = append(rspImage.RepoDigests, convertLXEAliasNameToDocker(a))
-		} else {
-			rspImage.RepoTags = append(rspImage.RepoTags, convertLXEAliasNameToDocker(a))
+	if req.GetVerbose() {
+		info, err := json.Marshal(toVerboseImageInfo(imgInfo))
+		if err != nil {
+			return nil, AnnErr(log, codes.Unknown, err, "failed to marshal verbose image info")
 		}
+
+		response.Info = map[string]string{"info": string(info)}
 	}
 
-	return &rtApi.ImageStatusResponse{Image: rspImage}, nil
+	return response, nil
 }
 
 // TODO
@@ -120,14 +110,46 @@ func (s ImageServer) PullImage(ctx context.Context, req *rtApi.PullImageRequest)
 	image := convertDockerImageNameToLXD(req.GetImage().GetImage())
 	log := log.WithContext(ctx).WithField("image", image)
 
-	hash, err := s.lxf.PullImage(image)
+	checks := &sourceChecks{}
+	check := checks.check(log, s.policy, req.GetSandboxConfig().GetMetadata().GetNamespace())
+
+	typ, err := s.instanceType(req.GetSandboxConfig())
+	if err != nil {
+		return nil, AnnErr(log, codes.Unknown, err, "failed to get instance type of pod")
+	}
+
+	hash, err := s.lxf.PullImage(ctx, image, typ, check)
 	if err != nil {
+		if checks.rejectedOnly() {
+			return nil, AnnErr(log, codes.PermissionDenied, err, "image rejected by policy")
+		}
+
 		return nil, AnnErr(log, codes.Unknown, err, "failed to pull image")
 	}
 
 	return &rtApi.PullImageResponse{ImageRef: hash}, nil
 }
 
+// instanceType returns the instance type of the runtime handler of the pod the image is pulled for. The pod isn't
+// always known, like for pulls by crictl, then the image is pulled for the default instance type.
+func (s ImageServer) instanceType(config *rtApi.PodSandboxConfig) (lxf.InstanceType, error) {
+	if s.criConfig == nil || s.handlers == nil {
+		return "", nil
+	}
+
+	name, has := s.handlers.get(config.GetMetadata().GetUid())
+	if !has {
+		return "", nil
+	}
+
+	handler, err := s.criConfig.RuntimeHandlers.get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return lxf.InstanceType(handler.Type), nil
+}
+
 // RemoveImage removes the image.
 // This call is idempotent, and must not return an error if the image has
 // already been removed.
@@ -135,7 +157,7 @@ func (s ImageServer) RemoveImage(ctx context.Context, req *rtApi.RemoveImageRequ
 	image := convertDockerImageNameToLXD(req.GetImage().GetImage())
 	log := log.WithContext(ctx).WithField("image", image)
 
-	err := s.lxf.RemoveImage(image)
+	err := s.lxf.RemoveImage(ctx, image)
 	if err != nil && !lxf.IsNotFoundError(err) {
 		return nil, AnnErr(log, codes.Unknown, err, "failed to remove image")
 	}
@@ -147,7 +169,7 @@ func (s ImageServer) RemoveImage(ctx context.Context, req *rtApi.RemoveImageRequ
 func (s ImageServer) ImageFsInfo(ctx context.Context, req *rtApi.ImageFsInfoRequest) (*rtApi.ImageFsInfoResponse, error) {
 	// log := log.WithContext(ctx)
 	// Images are not saved in pools (for now?)
-	// poolUsage, err := s.lxf.GetFSPoolUsage()
+	// poolUsage, err := s.lxf.GetFSPoolUsage(ctx)
 	// if err != nil {
 	// 	return nil, err
 	// }
@@ -1,8 +1,15 @@
 package cri
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/sirupsen/logrus"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
 // Convert docker image names to lxd image names.
@@ -41,3 +48,171 @@ func convertLXEAliasNameToDocker(name string) string {
 
 	return name
 }
+
+// toCRIImage converts a lxf image to a cri image
+func toCRIImage(imgInfo *lxf.Image) *rtApi.Image {
+	rspImage := &rtApi.Image{
+		Id:          imgInfo.Hash,
+		Size_:       uint64(imgInfo.Size),
+		RepoDigests: []string{},
+		RepoTags:    []string{},
+		Pinned:      imgInfo.Pinned,
+	}
+
+	for _, a := range imgInfo.Aliases {
+		if strings.Contains(a, "@sha256:") {
+			rspImage.RepoDigests = append(rspImage.RepoDigests, convertLXEAliasNameToDocker(a))
+		} else {
+			rspImage.RepoTags = append(rspImage.RepoTags, convertLXEAliasNameToDocker(a))
+		}
+	}
+
+	rspImage.Spec = &rtApi.ImageSpec{
+		Image:       imgInfo.Hash,
+		Annotations: imgInfo.Properties,
+	}
+	if len(rspImage.RepoTags) > 0 {
+		rspImage.Spec.Image = rspImage.RepoTags[0]
+	}
+
+	// a numeric username is the uid, as the cri expects it
+	if uid, err := strconv.ParseInt(imgInfo.Username, 10, 64); err == nil {
+		rspImage.Uid = &rtApi.Int64Value{Value: uid}
+	} else {
+		rspImage.Username = imgInfo.Username
+	}
+
+	return rspImage
+}
+
+// verboseImageInfo is returned as json in the verbose image status
+type verboseImageInfo struct {
+	Architecture string            `json:"architecture"`
+	OS           string            `json:"os,omitempty"`
+	Release      string            `json:"release,omitempty"`
+	Variant      string            `json:"variant,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Remote       string            `json:"remote,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UploadedAt   time.Time         `json:"uploadedAt"`
+	Properties   map[string]string `json:"properties"`
+}
+
+func toVerboseImageInfo(imgInfo *lxf.Image) verboseImageInfo {
+	return verboseImageInfo{
+		Architecture: imgInfo.Architecture,
+		OS:           imgInfo.Properties["os"],
+		Release:      imgInfo.Properties["release"],
+		Variant:      imgInfo.Properties["variant"],
+		Description:  imgInfo.Properties["description"],
+		Remote:       imgInfo.Remote,
+		CreatedAt:    imgInfo.CreatedAt,
+		UploadedAt:   imgInfo.UploadedAt,
+		Properties:   imgInfo.Properties,
+	}
+}
+
+// checkImagePolicy evaluates the image policy for an already pulled image used in the given sandbox
+func (s RuntimeServer) checkImagePolicy(ctx context.Context, sandboxID, image string, img *lxf.Image) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	sb, err := s.lxf.GetSandbox(ctx, sandboxID)
+	if err != nil {
+		return err
+	}
+
+	// images pulled by older versions don't know their remote, use the one from the requested name then
+	remote := img.Remote
+	if remote == "" {
+		remote, _, err = s.lxdConfig.ParseRemote(image)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.policy.check(remote, img.Hash, sb.Metadata.Namespace)
+}
+
+// sourceChecks counts the image sources allowed and rejected by the policy while pulling
+type sourceChecks struct {
+	allowed  int
+	rejected int
+}
+
+// check returns the check of the image sources against the policy for the namespace. Every source is checked, so a
+// source rejected by the policy isn't used when an allowed one fails.
+func (c *sourceChecks) check(log *logrus.Entry, policy *imagePolicy, namespace string) lxf.ImageSourceCheck {
+	if policy == nil {
+		return nil
+	}
+
+	return func(src *lxf.ImageSource) error {
+		err := policy.check(src.Remote, src.Fingerprint, namespace)
+		if err != nil {
+			c.rejected++
+
+			log.WithError(err).WithField("remote", src.Remote).Warn("image source rejected by policy")
+
+			return err
+		}
+
+		c.allowed++
+
+		return nil
+	}
+}
+
+// rejectedOnly reports whether sources were rejected by the policy but none was allowed
+func (c *sourceChecks) rejectedOnly() bool {
+	return c.rejected > 0 && c.allowed == 0
+}
+
+// imageVariant returns the image pulled for the instance type. kubelet finds an image by its name regardless of the
+// type it was pulled for, so the variant for the other type is looked up by the names of the image, and pulled if it's
+// missing.
+func (s RuntimeServer) imageVariant(ctx context.Context, log *logrus.Entry, image string, img *lxf.Image, typ lxf.InstanceType, namespace string) (*lxf.Image, error) {
+	if instanceTypeOrDefault(img.Type) == instanceTypeOrDefault(typ) {
+		return img, nil
+	}
+
+	for _, name := range img.Aliases {
+		variant, err := s.lxf.GetImageVariant(ctx, name, typ)
+		if err == nil {
+			return variant, nil
+		}
+
+		if !lxf.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	if len(img.Aliases) > 0 {
+		image = img.Aliases[0]
+	}
+
+	log.WithField("type", typ).Info("pulling image for instance type")
+
+	checks := &sourceChecks{}
+
+	hash, err := s.lxf.PullImage(ctx, image, typ, checks.check(log, s.policy, namespace))
+	if err != nil {
+		if checks.rejectedOnly() {
+			return nil, fmt.Errorf("%w: %v", ErrImagePolicy, err) // nolint: errorlint
+		}
+
+		return nil, err
+	}
+
+	return s.lxf.GetImage(ctx, hash)
+}
+
+// instanceTypeOrDefault returns the type of instances created for the empty type
+func instanceTypeOrDefault(typ lxf.InstanceType) lxf.InstanceType {
+	if typ == "" {
+		return lxf.InstanceTypeContainer
+	}
+
+	return typ
+}
@@ -1,7 +1,12 @@
 package cri
 
 import (
+	"context"
 	"testing"
+
+	crifakes "github.com/automaticserver/lxe/fakes/lxe/lxf"
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_convertDockerImageNameToLXC(t *testing.T) {
@@ -65,3 +70,57 @@ func Test_convertLXEAliasNameToDocker(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntimeServer_imageVariant_SameType(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	s := RuntimeServer{lxf: fake}
+	img := &lxf.Image{Hash: "aaaa1111", Type: lxf.InstanceTypeContainer, Aliases: []string{"images/ubuntu/jammy"}}
+
+	got, err := s.imageVariant(ctx, log, "images:ubuntu/jammy", img, "", "default")
+	assert.NoError(t, err)
+	assert.Same(t, img, got)
+	assert.Equal(t, 0, fake.GetImageVariantCallCount())
+	assert.Equal(t, 0, fake.PullImageCallCount())
+}
+
+func TestRuntimeServer_imageVariant_Pulled(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	s := RuntimeServer{lxf: fake}
+	img := &lxf.Image{Hash: "aaaa1111", Type: lxf.InstanceTypeContainer, Aliases: []string{"images/ubuntu/jammy"}}
+	vm := &lxf.Image{Hash: "bbbb2222", Type: lxf.InstanceTypeVirtualMachine}
+
+	fake.GetImageVariantReturns(vm, nil)
+
+	got, err := s.imageVariant(ctx, log, "aaaa1111", img, lxf.InstanceTypeVirtualMachine, "default")
+	assert.NoError(t, err)
+	assert.Same(t, vm, got)
+
+	_, name, typ := fake.GetImageVariantArgsForCall(0)
+	assert.Equal(t, "images/ubuntu/jammy", name)
+	assert.Equal(t, lxf.InstanceTypeVirtualMachine, typ)
+	assert.Equal(t, 0, fake.PullImageCallCount())
+}
+
+func TestRuntimeServer_imageVariant_Missing(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	s := RuntimeServer{lxf: fake, policy: &imagePolicy{conf: ImagePolicy{Remotes: []string{"images"}}}}
+	img := &lxf.Image{Hash: "aaaa1111", Type: lxf.InstanceTypeContainer, Aliases: []string{"images/ubuntu/jammy"}}
+
+	fake.GetImageVariantReturns(nil, lxf.ErrNotFound)
+	fake.PullImageCalls(func(ctx context.Context, image string, typ lxf.InstanceType, check lxf.ImageSourceCheck) (string, error) {
+		return "", check(&lxf.ImageSource{Remote: "ubuntu", Fingerprint: "bbbb2222"})
+	})
+
+	_, err := s.imageVariant(ctx, log, "aaaa1111", img, lxf.InstanceTypeVirtualMachine, "default")
+	assert.ErrorIs(t, err, ErrImagePolicy)
+
+	_, name, typ, _ := fake.PullImageArgsForCall(0)
+	assert.Equal(t, "images/ubuntu/jammy", name)
+	assert.Equal(t, lxf.InstanceTypeVirtualMachine, typ)
+}
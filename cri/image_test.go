@@ -2,11 +2,14 @@ package cri
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	crifakes "github.com/automaticserver/lxe/fakes/lxe/lxf"
+	"github.com/automaticserver/lxe/lxf"
 	"github.com/lxc/lxd/lxc/config"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
@@ -20,6 +23,7 @@ func testImageServer() (*ImageServer, *crifakes.FakeClient) {
 	return &ImageServer{
 		lxf:       fake,
 		lxdConfig: &config.DefaultConfig,
+		handlers:  newPodHandlers(),
 	}, fake
 }
 
@@ -39,5 +43,145 @@ func Test_ImageServer_PullImage(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1, fake.PullImageCallCount())
 	assert.Equal(t, "something", resp.ImageRef)
-	assert.Equal(t, "ubuntu:nextgen", fake.PullImageArgsForCall(0))
+
+	_, image, typ, _ := fake.PullImageArgsForCall(0)
+	assert.Equal(t, "ubuntu:nextgen", image)
+	assert.Equal(t, lxf.InstanceType(""), typ)
+}
+
+func Test_ImageServer_PullImage_RuntimeHandlerType(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testImageServer()
+	s.criConfig = &Config{RuntimeHandlers: RuntimeHandlers{"vm": {Type: "virtual-machine"}}}
+
+	s.handlers.set("uid", "vm")
+
+	_, err := s.PullImage(ctx, &rtApi.PullImageRequest{
+		Image:         &rtApi.ImageSpec{Image: "ubuntu/nextgen"},
+		SandboxConfig: &rtApi.PodSandboxConfig{Metadata: &rtApi.PodSandboxMetadata{Uid: "uid", Attempt: 1}},
+	})
+	assert.NoError(t, err)
+
+	_, _, typ, _ := fake.PullImageArgsForCall(0)
+	assert.Equal(t, lxf.InstanceTypeVirtualMachine, typ)
+	assert.Equal(t, 0, fake.ListSandboxesCallCount())
+}
+
+func Test_ImageServer_PullImage_UnknownPodType(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testImageServer()
+	s.criConfig = &Config{RuntimeHandlers: RuntimeHandlers{"vm": {Type: "virtual-machine"}}}
+	s.handlers.set("other", "vm")
+
+	_, err := s.PullImage(ctx, &rtApi.PullImageRequest{
+		Image:         &rtApi.ImageSpec{Image: "ubuntu/nextgen"},
+		SandboxConfig: &rtApi.PodSandboxConfig{Metadata: &rtApi.PodSandboxMetadata{Uid: "uid", Attempt: 1}},
+	})
+	assert.NoError(t, err)
+
+	_, _, typ, _ := fake.PullImageArgsForCall(0)
+	assert.Equal(t, lxf.InstanceType(""), typ)
+}
+
+func Test_ImageServer_PullImage_Policy(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testImageServer()
+	s.policy = &imagePolicy{conf: ImagePolicy{Remotes: []string{"images"}}}
+
+	fake.PullImageCalls(func(ctx context.Context, image string, typ lxf.InstanceType, check lxf.ImageSourceCheck) (string, error) {
+		return "", check(&lxf.ImageSource{Remote: "ubuntu", Fingerprint: "abc"})
+	})
+
+	_, err := s.PullImage(ctx, &rtApi.PullImageRequest{
+		Image: &rtApi.ImageSpec{
+			Image: "ubuntu/nextgen",
+		},
+	})
+
+	var annErr AnnotatedError
+
+	assert.ErrorAs(t, err, &annErr)
+	assert.Equal(t, codes.PermissionDenied, annErr.Code)
+}
+
+func Test_ImageServer_PullImage_PolicyEverySource(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testImageServer()
+	s.policy = &imagePolicy{conf: ImagePolicy{Remotes: []string{"images"}}}
+
+	checked := []error{}
+
+	// the allowed source fails to pull, so the next one is tried, which must be rejected again
+	fake.PullImageCalls(func(ctx context.Context, image string, typ lxf.InstanceType, check lxf.ImageSourceCheck) (string, error) {
+		checked = append(checked, check(&lxf.ImageSource{Remote: "images", Fingerprint: "abc"}))
+		checked = append(checked, check(&lxf.ImageSource{Remote: "ubuntu", Fingerprint: "def"}))
+
+		return "", errors.New("unable to pull")
+	})
+
+	_, err := s.PullImage(ctx, &rtApi.PullImageRequest{
+		Image: &rtApi.ImageSpec{
+			Image: "ubuntu/nextgen",
+		},
+	})
+
+	var annErr AnnotatedError
+
+	assert.ErrorAs(t, err, &annErr)
+	assert.Equal(t, codes.Unknown, annErr.Code)
+	assert.Len(t, checked, 2)
+	assert.NoError(t, checked[0])
+	assert.ErrorIs(t, checked[1], ErrImagePolicy)
+}
+
+func Test_ImageServer_ImageStatus_Verbose(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testImageServer()
+
+	fake.GetImageReturns(&lxf.Image{
+		Hash:         "abc",
+		Aliases:      []string{"images/ubuntu/jammy"},
+		Architecture: "x86_64",
+		Properties:   map[string]string{"os": "ubuntu", "release": "jammy"},
+		Username:     "1000",
+		Pinned:       true,
+	}, nil)
+
+	resp, err := s.ImageStatus(ctx, &rtApi.ImageStatusRequest{
+		Image:   &rtApi.ImageSpec{Image: "images/ubuntu/jammy"},
+		Verbose: true,
+	})
+
+	assert.NoError(t, err)
+
+	_, image := fake.GetImageArgsForCall(0)
+	assert.Equal(t, "images:ubuntu/jammy", image)
+	assert.Equal(t, []string{"images/ubuntu/jammy:latest"}, resp.Image.RepoTags)
+	assert.Equal(t, "images/ubuntu/jammy:latest", resp.Image.Spec.Image)
+	assert.Equal(t, int64(1000), resp.Image.Uid.GetValue())
+	assert.Empty(t, resp.Image.Username)
+	assert.True(t, resp.Image.Pinned)
+	assert.Contains(t, resp.Info["info"], `"architecture":"x86_64"`)
+	assert.Contains(t, resp.Info["info"], `"os":"ubuntu"`)
+	assert.Contains(t, resp.Info["info"], `"release":"jammy"`)
+}
+
+func Test_ImageServer_ListImages_Filter(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testImageServer()
+
+	_, err := s.ListImages(ctx, &rtApi.ListImagesRequest{
+		Filter: &rtApi.ImageFilter{Image: &rtApi.ImageSpec{Image: "images/ubuntu/jammy:latest"}},
+	})
+
+	assert.NoError(t, err)
+
+	_, filter := fake.ListImagesArgsForCall(0)
+	assert.Equal(t, "images:ubuntu/jammy", filter)
 }
@@ -0,0 +1,75 @@
+package cri
+
+import (
+	"context"
+	"time"
+
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/sirupsen/logrus"
+)
+
+// ReconcileImages connects to LXD and reconciles the images once
+func ReconcileImages(ctx context.Context, criConfig *Config) (*lxf.ImageReconcileReport, error) {
+	err := setDefaultLXDSocketPath(criConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setDefaultLXDConfigPath(criConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// a single run doesn't benefit from the cache
+	conn := lxdConnection(criConfig)
+	conn.Cache = lxf.CacheConfig{}
+
+	client, err := lxf.NewClient(conn, criConfig.LXDRemoteConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ReconcileImages(ctx, imageReconcileOptions(criConfig))
+}
+
+// runImageReconciler reconciles the images periodically and logs the findings
+func runImageReconciler(client lxf.Client, criConfig *Config) {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(criConfig.ImageGCInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		report, err := client.ReconcileImages(ctx, imageReconcileOptions(criConfig))
+		if err != nil {
+			log.WithError(err).Warn("image reconciliation failed")
+		}
+
+		if report != nil {
+			logImageReconcileReport(log, report)
+		}
+	}
+}
+
+func imageReconcileOptions(criConfig *Config) lxf.ImageReconcileOptions {
+	return lxf.ImageReconcileOptions{
+		Cleanup: criConfig.ImageGCCleanup,
+		MinAge:  criConfig.ImageGCMinAge,
+	}
+}
+
+func logImageReconcileReport(log *logrus.Entry, report *lxf.ImageReconcileReport) {
+	log = log.WithField("removed", report.Removed)
+
+	for _, alias := range report.DanglingAliases {
+		log.WithField("alias", alias).Info("found dangling image alias")
+	}
+
+	for _, fingerprint := range report.UnusedImages {
+		log.WithField("fingerprint", fingerprint).Info("found unused image")
+	}
+
+	for fingerprint, aliases := range report.DuplicateAliases {
+		log.WithFields(logrus.Fields{"fingerprint": fingerprint, "aliases": aliases}).Debug("found image with several aliases")
+	}
+}
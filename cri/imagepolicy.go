@@ -0,0 +1,196 @@
+package cri
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	imagePolicyAnyNamespace = "*"
+	imageSignatureExtension = ".sig"
+)
+
+var (
+	ErrImagePolicy        = errors.New("rejected by image policy")
+	ErrInvalidImagePolicy = errors.New("invalid image policy")
+	ErrInvalidKey         = errors.New("invalid trusted key")
+	ErrInvalidSignature   = errors.New("invalid signature")
+)
+
+// ImagePolicy restricts which images can be pulled and used
+type ImagePolicy struct {
+	// Remotes images may be pulled from. If empty, all remotes are allowed
+	Remotes []string
+	// Pinned lists per namespace the only image fingerprints which may be used in that namespace. A fingerprint may be
+	// shortened. The list of "*" applies to all namespaces without their own list
+	Pinned map[string][]string
+	// Signatures defines which images must be signed
+	Signatures ImageSignaturePolicy
+}
+
+// ImageSignaturePolicy requires images to have a detached signature over their fingerprint
+type ImageSignaturePolicy struct {
+	// Namespaces in which images must be signed, "*" matches all namespaces
+	Namespaces []string
+	// Keys is a directory containing PEM encoded ed25519 public keys which are trusted
+	Keys string
+	// Dir is a directory containing detached signatures named `<fingerprint>.sig`, either raw or base64 encoded
+	Dir string
+}
+
+// imagePolicy evaluates the ImagePolicy. A nil imagePolicy allows everything
+type imagePolicy struct {
+	conf ImagePolicy
+	keys []ed25519.PublicKey
+}
+
+// newImagePolicy prepares the policy and loads the trusted keys. Returns nil if the policy has no restrictions
+func newImagePolicy(conf ImagePolicy) (*imagePolicy, error) {
+	if len(conf.Remotes) == 0 && len(conf.Pinned) == 0 && len(conf.Signatures.Namespaces) == 0 {
+		return nil, nil // nolint: nilnil
+	}
+
+	// without any trusted key no image could be used in these namespaces
+	if len(conf.Signatures.Namespaces) > 0 && conf.Signatures.Keys == "" {
+		return nil, fmt.Errorf("%w: signatures are required but no trusted keys are set", ErrInvalidImagePolicy)
+	}
+
+	p := &imagePolicy{
+		conf: conf,
+	}
+
+	if conf.Signatures.Keys == "" {
+		return p, nil
+	}
+
+	files, err := os.ReadDir(conf.Signatures.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		key, err := readTrustedKey(filepath.Join(conf.Signatures.Keys, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		p.keys = append(p.keys, key)
+	}
+
+	return p, nil
+}
+
+func readTrustedKey(file string) (ed25519.PublicKey, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("%w: %s is not PEM encoded", ErrInvalidKey, file)
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidKey, file, err) // nolint: errorlint
+	}
+
+	key, is := pub.(ed25519.PublicKey)
+	if !is {
+		return nil, fmt.Errorf("%w: %s is not an ed25519 key", ErrInvalidKey, file)
+	}
+
+	return key, nil
+}
+
+// check returns an error wrapping ErrImagePolicy if the image from remote with fingerprint must not be used in
+// namespace
+func (p *imagePolicy) check(remote, fingerprint, namespace string) error {
+	if p == nil {
+		return nil
+	}
+
+	if len(p.conf.Remotes) > 0 && !containsString(p.conf.Remotes, remote) {
+		return fmt.Errorf("%w: remote '%s' is not allowed", ErrImagePolicy, remote)
+	}
+
+	if pinned, has := p.pinned(namespace); has && !isPinned(pinned, fingerprint) {
+		return fmt.Errorf("%w: fingerprint '%s' is not pinned for namespace '%s'", ErrImagePolicy, fingerprint, namespace)
+	}
+
+	if containsString(p.conf.Signatures.Namespaces, namespace) || containsString(p.conf.Signatures.Namespaces, imagePolicyAnyNamespace) {
+		err := p.verify(fingerprint)
+		if err != nil {
+			return fmt.Errorf("%w: fingerprint '%s' has no trusted signature: %v", ErrImagePolicy, fingerprint, err) // nolint: errorlint
+		}
+	}
+
+	return nil
+}
+
+// verify checks whether the detached signature of the fingerprint is signed by any of the trusted keys
+func (p *imagePolicy) verify(fingerprint string) error {
+	b, err := os.ReadFile(filepath.Join(p.conf.Signatures.Dir, fingerprint+imageSignatureExtension))
+	if err != nil {
+		return err
+	}
+
+	sig := b
+	if len(sig) != ed25519.SignatureSize {
+		sig, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(b)))
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSignature, err) // nolint: errorlint
+		}
+	}
+
+	for _, key := range p.keys {
+		if ed25519.Verify(key, []byte(fingerprint), sig) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// pinned returns the fingerprints pinned for the namespace, the ones of "*" if the namespace has none
+func (p *imagePolicy) pinned(namespace string) ([]string, bool) {
+	if pinned, has := p.conf.Pinned[namespace]; has {
+		return pinned, true
+	}
+
+	pinned, has := p.conf.Pinned[imagePolicyAnyNamespace]
+
+	return pinned, has
+}
+
+func isPinned(pinned []string, fingerprint string) bool {
+	for _, pin := range pinned {
+		if pin != "" && strings.HasPrefix(fingerprint, pin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
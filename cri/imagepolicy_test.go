@@ -0,0 +1,126 @@
+package cri
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFingerprint = "4b7ae5ee4fe5cdc5d2ba2d4d26fdbd1c5b0c5e7e3fbc3aeedb1b1dc0a0a0a0a0"
+
+func testSignedPolicy(t *testing.T) (ImagePolicy, ed25519.PrivateKey) {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	keys := t.TempDir()
+	err = os.WriteFile(filepath.Join(keys, "trusted.pem"), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600)
+	require.NoError(t, err)
+
+	return ImagePolicy{
+		Signatures: ImageSignaturePolicy{
+			Namespaces: []string{"secure"},
+			Keys:       keys,
+			Dir:        t.TempDir(),
+		},
+	}, priv
+}
+
+func Test_newImagePolicy_Empty(t *testing.T) {
+	t.Parallel()
+
+	p, err := newImagePolicy(ImagePolicy{})
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+	assert.NoError(t, p.check("anything", testFingerprint, "default"))
+}
+
+func Test_newImagePolicy_InvalidKey(t *testing.T) {
+	t.Parallel()
+
+	keys := t.TempDir()
+	err := os.WriteFile(filepath.Join(keys, "broken.pem"), []byte("nope"), 0600)
+	require.NoError(t, err)
+
+	_, err = newImagePolicy(ImagePolicy{Signatures: ImageSignaturePolicy{Namespaces: []string{"*"}, Keys: keys}})
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func Test_newImagePolicy_SignaturesWithoutKeys(t *testing.T) {
+	t.Parallel()
+
+	_, err := newImagePolicy(ImagePolicy{Signatures: ImageSignaturePolicy{Namespaces: []string{"secure"}}})
+	assert.ErrorIs(t, err, ErrInvalidImagePolicy)
+}
+
+func Test_imagePolicy_check_Remotes(t *testing.T) {
+	t.Parallel()
+
+	p, err := newImagePolicy(ImagePolicy{Remotes: []string{"images", "internal-mirror"}})
+	require.NoError(t, err)
+
+	assert.NoError(t, p.check("internal-mirror", testFingerprint, "default"))
+	assert.ErrorIs(t, p.check("ubuntu", testFingerprint, "default"), ErrImagePolicy)
+}
+
+func Test_imagePolicy_check_Pinned(t *testing.T) {
+	t.Parallel()
+
+	p, err := newImagePolicy(ImagePolicy{Pinned: map[string][]string{"prod": {"4b7ae5"}}})
+	require.NoError(t, err)
+
+	assert.NoError(t, p.check("images", testFingerprint, "prod"))
+	assert.ErrorIs(t, p.check("images", "ffff", "prod"), ErrImagePolicy)
+	assert.NoError(t, p.check("images", "ffff", "default"))
+}
+
+func Test_imagePolicy_check_PinnedAnyNamespace(t *testing.T) {
+	t.Parallel()
+
+	p, err := newImagePolicy(ImagePolicy{Pinned: map[string][]string{"*": {"4b7ae5"}, "dev": {"ffff"}}})
+	require.NoError(t, err)
+
+	assert.NoError(t, p.check("images", testFingerprint, "default"))
+	assert.ErrorIs(t, p.check("images", "ffff", "default"), ErrImagePolicy)
+	// the own list of a namespace replaces the one of "*"
+	assert.NoError(t, p.check("images", "ffff", "dev"))
+	assert.ErrorIs(t, p.check("images", testFingerprint, "dev"), ErrImagePolicy)
+}
+
+func Test_imagePolicy_check_Signatures(t *testing.T) {
+	t.Parallel()
+
+	conf, priv := testSignedPolicy(t)
+
+	p, err := newImagePolicy(conf)
+	require.NoError(t, err)
+
+	// not required in other namespaces
+	assert.NoError(t, p.check("images", testFingerprint, "default"))
+
+	// missing signature
+	assert.ErrorIs(t, p.check("images", testFingerprint, "secure"), ErrImagePolicy)
+
+	// raw signature
+	err = os.WriteFile(filepath.Join(conf.Signatures.Dir, testFingerprint+".sig"), ed25519.Sign(priv, []byte(testFingerprint)), 0600)
+	require.NoError(t, err)
+	assert.NoError(t, p.check("images", testFingerprint, "secure"))
+
+	// base64 signature over another fingerprint
+	other := "aaaa" + testFingerprint[4:]
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(testFingerprint)))
+	err = os.WriteFile(filepath.Join(conf.Signatures.Dir, other+".sig"), []byte(sig+"\n"), 0600)
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.check("images", other, "secure"), ErrImagePolicy)
+}
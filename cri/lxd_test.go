@@ -0,0 +1,278 @@
+package cri
+
+import (
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	"github.com/automaticserver/lxe/lxf"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLXD keeps the profiles and instances created through it, so requests can run against lxf without a LXD
+type fakeLXD struct {
+	*lxdfakes.FakeInstanceServer
+
+	mu        sync.Mutex
+	profiles  map[string]api.Profile
+	instances map[string]api.Instance
+}
+
+func newFakeLXD() *fakeLXD {
+	f := &fakeLXD{
+		FakeInstanceServer: &lxdfakes.FakeInstanceServer{},
+		profiles:           map[string]api.Profile{},
+		instances:          map[string]api.Instance{},
+	}
+
+	f.UseProjectStub = func(string) lxd.InstanceServer { return f }
+
+	f.GetProfileStub = f.getProfile
+	f.GetProfilesStub = f.getProfiles
+	f.CreateProfileStub = f.createProfile
+	f.UpdateProfileStub = f.updateProfile
+	f.DeleteProfileStub = f.deleteProfile
+
+	f.GetInstanceStub = f.getInstance
+	f.GetInstancesStub = f.getInstances
+	f.GetInstanceStateStub = f.getInstanceState
+	f.CreateInstanceStub = f.createInstance
+	f.UpdateInstanceStub = f.updateInstance
+	f.UpdateInstanceStateStub = f.updateInstanceState
+	f.DeleteInstanceStub = f.deleteInstance
+
+	// every image is known and pulled by lxe
+	f.GetImageAliasStub = func(alias string) (*api.ImageAliasesEntry, string, error) {
+		return &api.ImageAliasesEntry{Name: alias, ImageAliasesEntryPut: api.ImageAliasesEntryPut{Target: "hash"}}, "", nil
+	}
+	f.GetImageStub = func(fingerprint string) (*api.Image, string, error) {
+		return &api.Image{Fingerprint: fingerprint, ImagePut: api.ImagePut{Properties: map[string]string{"user.cri": "true"}}}, "", nil
+	}
+
+	return f
+}
+
+// testLXDRuntimeServer returns a runtime server using lxf with a fake LXD
+func testLXDRuntimeServer(t *testing.T) (RuntimeServer, *fakeLXD) {
+	t.Helper()
+
+	f := newFakeLXD()
+
+	client, err := lxf.NewClientWithServer(f, lxf.ConnectionConfig{})
+	require.NoError(t, err)
+
+	return RuntimeServer{
+		lxf:       client,
+		criConfig: &Config{LXENetworkPlugin: NetworkPluginCNI},
+		network:   &recordingNetworkPlugin{},
+		locks:     newRequestLocks(),
+		handlers:  newPodHandlers(),
+		netStatus: newNetworkStatuses(),
+	}, f
+}
+
+func notFound(kind string) error {
+	return api.StatusErrorf(http.StatusNotFound, "%s not found", kind)
+}
+
+func doneOperation() lxd.Operation { // nolint: ireturn
+	return &lxdfakes.FakeOperation{}
+}
+
+func (f *fakeLXD) getProfile(name string) (*api.Profile, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, has := f.profiles[name]
+	if !has {
+		return nil, "", notFound("Profile")
+	}
+
+	return &p, "etag", nil
+}
+
+func (f *fakeLXD) getProfiles() ([]api.Profile, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	profiles := []api.Profile{}
+	for _, p := range f.profiles {
+		profiles = append(profiles, p)
+	}
+
+	return profiles, nil
+}
+
+func (f *fakeLXD) createProfile(post api.ProfilesPost) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.profiles[post.Name] = api.Profile{Name: post.Name, ProfilePut: post.ProfilePut, UsedBy: []string{}}
+
+	return nil
+}
+
+func (f *fakeLXD) updateProfile(name string, put api.ProfilePut, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, has := f.profiles[name]
+	if !has {
+		return notFound("Profile")
+	}
+
+	p.ProfilePut = put
+	f.profiles[name] = p
+
+	return nil
+}
+
+func (f *fakeLXD) deleteProfile(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, has := f.profiles[name]; !has {
+		return notFound("Profile")
+	}
+
+	delete(f.profiles, name)
+
+	return nil
+}
+
+func (f *fakeLXD) getInstance(name string) (*api.Instance, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i, has := f.instances[name]
+	if !has {
+		return nil, "", notFound("Instance")
+	}
+
+	return &i, "etag", nil
+}
+
+func (f *fakeLXD) getInstances(api.InstanceType) ([]api.Instance, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	instances := []api.Instance{}
+	for _, i := range f.instances {
+		instances = append(instances, i)
+	}
+
+	return instances, nil
+}
+
+func (f *fakeLXD) getInstanceState(name string) (*api.InstanceState, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i, has := f.instances[name]
+	if !has {
+		return nil, "", notFound("Instance")
+	}
+
+	// running instances are the test process
+	state := &api.InstanceState{Status: i.Status, StatusCode: i.StatusCode}
+	if i.StatusCode == api.Running {
+		state.Pid = int64(os.Getpid())
+	}
+
+	return state, "", nil
+}
+
+func (f *fakeLXD) createInstance(post api.InstancesPost) (lxd.Operation, error) { // nolint: ireturn
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.instances[post.Name] = api.Instance{
+		Name:        post.Name,
+		Type:        string(post.Type),
+		InstancePut: post.InstancePut,
+		Status:      api.Stopped.String(),
+		StatusCode:  api.Stopped,
+	}
+	f.setUsedBy(post.Name, post.Profiles)
+
+	return doneOperation(), nil
+}
+
+func (f *fakeLXD) updateInstance(name string, put api.InstancePut, _ string) (lxd.Operation, error) { // nolint: ireturn
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i, has := f.instances[name]
+	if !has {
+		return nil, notFound("Instance")
+	}
+
+	i.InstancePut = put
+	f.instances[name] = i
+
+	return doneOperation(), nil
+}
+
+func (f *fakeLXD) updateInstanceState(name string, put api.InstanceStatePut, _ string) (lxd.Operation, error) { // nolint: ireturn
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i, has := f.instances[name]
+	if !has {
+		return nil, notFound("Instance")
+	}
+
+	switch put.Action {
+	case "start":
+		i.StatusCode = api.Running
+	case "stop":
+		i.StatusCode = api.Stopped
+	}
+
+	i.Status = i.StatusCode.String()
+	f.instances[name] = i
+
+	return doneOperation(), nil
+}
+
+func (f *fakeLXD) deleteInstance(name string) (lxd.Operation, error) { // nolint: ireturn
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, has := f.instances[name]; !has {
+		return nil, notFound("Instance")
+	}
+
+	delete(f.instances, name)
+	f.setUsedBy(name, nil)
+
+	return doneOperation(), nil
+}
+
+// setUsedBy records the instance in UsedBy of the profiles, needs the lock
+func (f *fakeLXD) setUsedBy(instance string, profiles []string) {
+	url := "/1.0/instances/" + instance
+
+	for name, p := range f.profiles {
+		usedBy := []string{}
+
+		for _, u := range p.UsedBy {
+			if u != url {
+				usedBy = append(usedBy, u)
+			}
+		}
+
+		for _, used := range profiles {
+			if used == name {
+				usedBy = append(usedBy, url)
+			}
+		}
+
+		p.UsedBy = usedBy
+		f.profiles[name] = p
+	}
+}
@@ -0,0 +1,168 @@
+package cri
+
+import (
+	"context"
+	"time"
+
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/automaticserver/lxe/network"
+	"github.com/dionysius/errand"
+	"github.com/sirupsen/logrus"
+)
+
+// GarbageReport lists the findings of a garbage collection
+type GarbageReport struct {
+	// Objects are the sandboxes and containers found
+	Objects *lxf.ObjectReconcileReport `json:"objects" yaml:"objects"`
+	// NetworkLeftovers are what's left of the network of pods which don't exist anymore
+	NetworkLeftovers []network.Leftover `json:"networkLeftovers" yaml:"networkLeftovers"`
+	// Removed is true if the found objects and removable network leftovers have been removed
+	Removed bool `json:"removed" yaml:"removed"`
+}
+
+// collectGarbage finds stopped sandboxes without containers, containers without sandbox, cri objects in an outdated schema
+// and network leftovers of pods which don't exist anymore. Only what's older than the grace period is considered. They
+// are removed unless it's a dry run.
+func (s RuntimeServer) collectGarbage(ctx context.Context) (*GarbageReport, error) {
+	objects, err := s.lxf.ReconcileObjects(ctx, lxf.ObjectReconcileOptions{
+		Cleanup: !s.criConfig.GCDryRun,
+		MinAge:  s.criConfig.GCGracePeriod,
+	})
+	if objects == nil {
+		return nil, err
+	}
+
+	report := &GarbageReport{Objects: objects, NetworkLeftovers: []network.Leftover{}}
+	errs := err
+
+	if !s.criConfig.GCDryRun {
+		errs = errand.Append(errs, s.removeEmptySandboxes(ctx, objects.EmptySandboxes))
+	}
+
+	collector, ok := s.network.(network.LeftoverCollector)
+	if !ok {
+		report.Removed = !s.criConfig.GCDryRun && errs == nil
+
+		return report, errs
+	}
+
+	// listed after the objects were removed, so the network of removed sandboxes is found as well
+	sandboxes, err := s.lxf.ListSandboxes(ctx)
+	if err != nil {
+		return report, errand.Append(errs, err)
+	}
+
+	pods := map[string]bool{}
+	for _, sb := range sandboxes {
+		pods[sb.ID] = true
+	}
+
+	leftovers, err := collector.Leftovers(ctx, pods)
+	if err != nil {
+		return report, errand.Append(errs, err)
+	}
+
+	for _, leftover := range leftovers {
+		if time.Since(leftover.ModifiedAt) >= s.criConfig.GCGracePeriod {
+			report.NetworkLeftovers = append(report.NetworkLeftovers, leftover)
+		}
+	}
+
+	if s.criConfig.GCDryRun {
+		return report, errs
+	}
+
+	for _, leftover := range report.NetworkLeftovers {
+		if !leftover.Removable {
+			continue
+		}
+
+		log.WithFields(logrus.Fields{"kind": leftover.Kind, "name": leftover.Name}).Info("removing network leftover")
+
+		err = collector.RemoveLeftover(ctx, leftover)
+		if err != nil {
+			errs = errand.Append(errs, err)
+		}
+	}
+
+	report.Removed = errs == nil
+
+	return report, errs
+}
+
+// removeEmptySandboxes stops and deletes the sandboxes like kubelet would, so their network is torn down as well. A
+// sandbox which was started or removed in the meantime is skipped.
+func (s RuntimeServer) removeEmptySandboxes(ctx context.Context, ids []string) error {
+	var errs error
+
+	for _, id := range ids {
+		sb, err := s.lxf.GetSandbox(ctx, id)
+		if err != nil {
+			if !lxf.IsNotFoundError(err) {
+				errs = errand.Append(errs, err)
+			}
+
+			continue
+		}
+
+		if sb.State == lxf.SandboxReady {
+			continue
+		}
+
+		log.WithField("podid", id).Info("removing sandbox without containers")
+
+		err = s.stopSandbox(ctx, sb)
+		if err == nil {
+			err = s.deleteSandbox(ctx, sb)
+		}
+
+		if err != nil {
+			errs = errand.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// runGarbageCollector collects garbage periodically and logs the findings
+func (s RuntimeServer) runGarbageCollector() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(s.criConfig.GCInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		report, err := s.collectGarbage(ctx)
+		if err != nil {
+			log.WithError(err).Warn("garbage collection failed")
+		}
+
+		if report != nil {
+			logGarbageReport(log, report)
+		}
+	}
+}
+
+func logGarbageReport(log *logrus.Entry, report *GarbageReport) {
+	log = log.WithField("removed", report.Removed)
+
+	for _, id := range report.Objects.EmptySandboxes {
+		log.WithField("podid", id).Info("found sandbox without containers")
+	}
+
+	for _, id := range report.Objects.OrphanedContainers {
+		log.WithField("containerid", id).Info("found container without sandbox")
+	}
+
+	for _, id := range report.Objects.OutdatedSandboxes {
+		log.WithField("podid", id).Info("found sandbox in outdated schema")
+	}
+
+	for _, id := range report.Objects.OutdatedContainers {
+		log.WithField("containerid", id).Info("found container in outdated schema")
+	}
+
+	for _, leftover := range report.NetworkLeftovers {
+		log.WithFields(logrus.Fields{"kind": leftover.Kind, "name": leftover.Name, "removable": leftover.Removable}).Info("found network leftover")
+	}
+}
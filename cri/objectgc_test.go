@@ -0,0 +1,139 @@
+package cri
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	crifakes "github.com/automaticserver/lxe/fakes/lxe/lxf"
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/automaticserver/lxe/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// leftoverNetworkPlugin reports the leftovers and records the removed ones
+type leftoverNetworkPlugin struct {
+	network.Plugin
+	leftovers []network.Leftover
+	pods      map[string]bool
+	removed   []string
+}
+
+func (p *leftoverNetworkPlugin) Leftovers(_ context.Context, pods map[string]bool) ([]network.Leftover, error) {
+	p.pods = pods
+
+	return p.leftovers, nil
+}
+
+func (p *leftoverNetworkPlugin) RemoveLeftover(_ context.Context, leftover network.Leftover) error {
+	if !leftover.Removable {
+		return errors.New("not removable")
+	}
+
+	p.removed = append(p.removed, leftover.Name)
+
+	return nil
+}
+
+func testCollectGarbage(dryRun bool) (RuntimeServer, *crifakes.FakeClient, *leftoverNetworkPlugin) {
+	fake := &crifakes.FakeClient{}
+	fake.ReconcileObjectsReturns(&lxf.ObjectReconcileReport{EmptySandboxes: []string{"empty"}, Removed: !dryRun}, nil)
+	fake.ListSandboxesReturns([]*lxf.Sandbox{testSandbox("alive", lxf.SandboxReady)}, nil)
+	// the empty sandbox was removed in the meantime
+	fake.GetSandboxReturns(nil, lxf.ErrNotFound)
+
+	plugin := &leftoverNetworkPlugin{leftovers: []network.Leftover{
+		{Kind: "netns", Name: "gone", Removable: true},
+		{Kind: "netns", Name: "fresh", Removable: true, ModifiedAt: time.Now()},
+		{Kind: "lease", Name: "10.0.0.2 gone"},
+	}}
+
+	s := RuntimeServer{
+		lxf:       fake,
+		network:   plugin,
+		criConfig: &Config{GCGracePeriod: time.Hour, GCDryRun: dryRun},
+		netStatus: newNetworkStatuses(),
+	}
+
+	return s, fake, plugin
+}
+
+func TestRuntimeServer_collectGarbage_DryRun(t *testing.T) {
+	t.Parallel()
+
+	s, fake, plugin := testCollectGarbage(true)
+
+	report, err := s.collectGarbage(ctx)
+	assert.NoError(t, err)
+	assert.False(t, report.Removed)
+	assert.Equal(t, []string{"empty"}, report.Objects.EmptySandboxes)
+	assert.Len(t, report.NetworkLeftovers, 2)
+	assert.Equal(t, map[string]bool{"alive": true}, plugin.pods)
+	assert.Empty(t, plugin.removed)
+
+	_, opts := fake.ReconcileObjectsArgsForCall(0)
+	assert.Equal(t, lxf.ObjectReconcileOptions{Cleanup: false, MinAge: time.Hour}, opts)
+}
+
+func TestRuntimeServer_collectGarbage_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	s, fake, plugin := testCollectGarbage(false)
+
+	report, err := s.collectGarbage(ctx)
+	assert.NoError(t, err)
+	assert.True(t, report.Removed)
+	assert.Equal(t, []string{"gone"}, plugin.removed)
+
+	_, opts := fake.ReconcileObjectsArgsForCall(0)
+	assert.True(t, opts.Cleanup)
+}
+
+func TestRuntimeServer_collectGarbage_NoCollector(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	fake.ReconcileObjectsReturns(&lxf.ObjectReconcileReport{Removed: true}, nil)
+
+	s := RuntimeServer{lxf: fake, network: &recordingNetworkPlugin{}, criConfig: &Config{}, netStatus: newNetworkStatuses()}
+
+	report, err := s.collectGarbage(ctx)
+	assert.NoError(t, err)
+	assert.True(t, report.Removed)
+	assert.Empty(t, report.NetworkLeftovers)
+	assert.Equal(t, 0, fake.ListSandboxesCallCount())
+}
+
+func TestRuntimeServer_collectGarbage_EmptySandboxes(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	plugin := &recordingNetworkPlugin{}
+	s.network = plugin
+	s.criConfig.GCGracePeriod = 0
+
+	stopped, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("stopped"))
+	require.NoError(t, err)
+
+	_, err = s.StopPodSandbox(ctx, &rtApi.StopPodSandboxRequest{PodSandboxId: stopped.GetPodSandboxId()})
+	require.NoError(t, err)
+
+	// kubelet creates the containers after the sandbox
+	ready, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("ready"))
+	require.NoError(t, err)
+
+	plugin.calls = nil
+
+	report, err := s.collectGarbage(ctx)
+	assert.NoError(t, err)
+	assert.True(t, report.Removed)
+	assert.Equal(t, []string{stopped.GetPodSandboxId()}, report.Objects.EmptySandboxes)
+
+	// the network is torn down like when kubelet removes the sandbox
+	assert.Equal(t, []string{"pod stopped", "pod deleted"}, plugin.calls)
+	assert.NotContains(t, fake.profiles, stopped.GetPodSandboxId())
+	assert.Contains(t, fake.profiles, ready.GetPodSandboxId())
+}
@@ -0,0 +1,178 @@
+package cri
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/automaticserver/lxe/network"
+)
+
+// reconcileTimeout is how long reconciling the pods and containers after a start of LXE may take
+const reconcileTimeout = 5 * time.Minute
+
+// runReconcile reconciles the pods and containers within reconcileTimeout and logs a failure
+func (s RuntimeServer) runReconcile() {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	err := s.reconcile(ctx)
+	if err != nil {
+		log.WithError(err).Error("Unable to reconcile pods and containers")
+	}
+}
+
+// reconcile corrects the recorded state of the pods and containers after LXE or the host was restarted, as lifecycle
+// events of LXD might have been missed in the meantime. A pod whose network got lost gets it set up again and a running
+// container which isn't in the network of its pod, like one autostarted by LXD, is restarted or gets its network
+// started again. A job isn't restarted, as it would run its command again, it's only reported. Running jobs are waited
+// for again. Failures are logged and the remaining objects are still reconciled.
+func (s RuntimeServer) reconcile(ctx context.Context) error {
+	sandboxes, err := s.lxf.ListSandboxes(ctx)
+	if err != nil {
+		return err
+	}
+
+	// pods whose network was set up again
+	restored := map[string]bool{}
+
+	for _, sb := range sandboxes {
+		// images are pulled for the instance type of the pods run before LXE was started
+		s.handlers.set(sb.Metadata.UID, sb.RuntimeHandler)
+
+		restored[sb.ID], err = s.reconcileSandbox(ctx, sb)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithField("podid", sb.ID).Error("unable to reconcile pod")
+		}
+	}
+
+	containers, err := s.lxf.ListContainers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		err = s.reconcileContainer(ctx, c, restored[c.SandboxID()])
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithField("containerid", c.ID).Error("unable to reconcile container")
+		}
+	}
+
+	return nil
+}
+
+// reconcileSandbox sets up the network of a ready pod again if it got lost, e.g. after a reboot. It reports whether
+// the network was set up again.
+func (s RuntimeServer) reconcileSandbox(ctx context.Context, sb *lxf.Sandbox) (bool, error) {
+	if sb.State != lxf.SandboxReady || sb.NetworkConfig.Mode == lxf.NetworkHost {
+		return false, nil
+	}
+
+	_, ready := s.getNetworkStatus(ctx, sb)
+	if netns := podNetNamespace(sb); ready && (netns == "" || network.IsNetns(netns)) {
+		return false, nil
+	}
+
+	log.WithContext(ctx).WithField("podid", sb.ID).Warn("setting up lost pod network again")
+
+	podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
+	if err != nil {
+		return false, fmt.Errorf("can't enter pod network context: %w", err)
+	}
+
+	// release what's left of the network, like the address of the pod
+	_ = podNet.WhenStopped(ctx, &network.Properties{Data: sb.NetworkConfig.ModeData})
+
+	res, err := podNet.WhenStarted(ctx, &network.PropertiesRunning{
+		Properties: network.Properties{
+			Data: sb.NetworkConfig.ModeData,
+		},
+		Pid: 0,
+	})
+	if err != nil {
+		return false, fmt.Errorf("can't start pod network: %w", err)
+	}
+
+	err = s.handleNetworkResult(ctx, sb, res)
+	if err != nil {
+		return true, fmt.Errorf("unable to save start pod network result: %w", err)
+	}
+
+	return true, nil
+}
+
+// reconcileContainer corrects the recorded state and the network of the container. The command of a running job is
+// waited for again, as it was only watched by the previous LXE process, unless the job is run again as its container
+// was restarted.
+func (s RuntimeServer) reconcileContainer(ctx context.Context, c *lxf.Container, podNetRestored bool) error {
+	started, err := c.Reconcile(ctx)
+	if err != nil {
+		return err
+	}
+
+	restarted, err := s.reconcileContainerNetwork(ctx, c, started || podNetRestored)
+	if !restarted {
+		c.ResumeJob(ctx)
+	}
+
+	return err
+}
+
+// reconcileContainerNetwork makes sure a running container is in the network of its pod. The containers join the
+// network namespace of the pod when they are started, so a container which isn't in it, like one started by LXD before
+// the namespace was set up again after a reboot, is restarted, unless it's a job. Otherwise the network of the container
+// is started again if it might be missing, as indicated by lost. It reports whether the container was restarted.
+func (s RuntimeServer) reconcileContainerNetwork(ctx context.Context, c *lxf.Container, lost bool) (bool, error) {
+	if c.StateName != lxf.ContainerStateRunning {
+		return false, nil
+	}
+
+	sb, err := c.Sandbox(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if sb.NetworkConfig.Mode == lxf.NetworkHost {
+		return false, nil
+	}
+
+	log := log.WithContext(ctx).WithField("containerid", c.ID)
+
+	if netns := podNetNamespace(sb); netns != "" {
+		st, err := c.State(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		joined, err := network.IsInNetns(st.Pid, netns)
+		if err != nil {
+			return false, fmt.Errorf("unable to find network namespace of container: %w", err)
+		}
+
+		if !joined && c.Job {
+			log.Warn("job container isn't in the network namespace of the pod, not restarting it")
+
+			return false, nil
+		}
+
+		if !joined {
+			log.Warn("restarting container to join the network namespace of the pod")
+
+			err = c.Stop(ctx, defaultTimeoutContainerStop)
+			if err != nil {
+				return false, err
+			}
+
+			return true, c.Start(ctx)
+		}
+	}
+
+	if !lost {
+		return false, nil
+	}
+
+	log.Warn("starting network of container again")
+
+	return false, s.ContainerStarted(ctx, c)
+}
@@ -0,0 +1,155 @@
+package cri
+
+import (
+	"testing"
+
+	crifakes "github.com/automaticserver/lxe/fakes/lxe/lxf"
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestRuntimeServer_reconcile_NetworkLost(t *testing.T) {
+	t.Parallel()
+
+	lost := testSandbox("lost", lxf.SandboxReady)
+	stopped := testSandbox("stopped", lxf.SandboxNotReady)
+	host := testSandbox("host", lxf.SandboxReady)
+	host.NetworkConfig.Mode = lxf.NetworkHost
+
+	fake := &crifakes.FakeClient{}
+	fake.ListSandboxesReturns([]*lxf.Sandbox{lost, stopped, host}, nil)
+
+	plugin := &recordingNetworkPlugin{fail: map[string]bool{"pod status": true}}
+	s := RuntimeServer{lxf: fake, network: plugin, handlers: newPodHandlers(), netStatus: newNetworkStatuses()}
+
+	err := s.reconcile(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pod stopped", "pod started"}, plugin.calls)
+	assert.Equal(t, 1, fake.ListContainersCallCount())
+}
+
+func TestRuntimeServer_reconcile_NetworkReady(t *testing.T) {
+	t.Parallel()
+
+	sb := testSandbox("foo", lxf.SandboxReady)
+	sb.Metadata.UID = "uid"
+	sb.RuntimeHandler = "vm"
+
+	fake := &crifakes.FakeClient{}
+	fake.ListSandboxesReturns([]*lxf.Sandbox{sb}, nil)
+
+	plugin := &recordingNetworkPlugin{}
+	s := RuntimeServer{lxf: fake, network: plugin, handlers: newPodHandlers(), netStatus: newNetworkStatuses()}
+
+	err := s.reconcile(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, plugin.calls)
+
+	// images are pulled for the type of the pods which existed before
+	handler, has := s.handlers.get("uid")
+	assert.True(t, has)
+	assert.Equal(t, "vm", handler)
+}
+
+// testRunningContainer runs a pod with a started container
+func testRunningContainer(t *testing.T, s RuntimeServer) string {
+	t.Helper()
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	cont, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	_, err = s.StartContainer(ctx, &rtApi.StartContainerRequest{ContainerId: cont.GetContainerId()})
+	require.NoError(t, err)
+
+	return cont.GetContainerId()
+}
+
+// stateActions returns the actions to change the state of the instance
+func stateActions(fake *fakeLXD, id string) []string {
+	actions := []string{}
+
+	for i := 0; i < fake.UpdateInstanceStateCallCount(); i++ {
+		name, state, _ := fake.UpdateInstanceStateArgsForCall(i)
+		if name == id {
+			actions = append(actions, state.Action)
+		}
+	}
+
+	return actions
+}
+
+func TestRuntimeServer_reconcile_ContainerNotInPodNetNamespace(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	// a namespace the test process is not in, like one set up again after the container was autostarted
+	s.network = &recordingNetworkPlugin{netns: "/proc/self/ns/ipc"}
+
+	id := testRunningContainer(t, s)
+
+	err := s.reconcile(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"start", "stop", "start"}, stateActions(fake, id))
+}
+
+func TestRuntimeServer_reconcile_JobNotInPodNetNamespace(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	s.network = &recordingNetworkPlugin{netns: "/proc/self/ns/ipc"}
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	// an interactive job, so its command isn't run by the test
+	cont, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{Stdin: true}))
+	require.NoError(t, err)
+
+	_, err = s.StartContainer(ctx, &rtApi.StartContainerRequest{ContainerId: cont.GetContainerId()})
+	require.NoError(t, err)
+
+	// a job would run its command again when it's restarted
+	err = s.reconcile(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"start"}, stateActions(fake, cont.GetContainerId()))
+}
+
+func TestRuntimeServer_reconcile_ContainerInPodNetNamespace(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	plugin := &recordingNetworkPlugin{netns: "/proc/self/ns/net"}
+	s.network = plugin
+
+	id := testRunningContainer(t, s)
+	plugin.calls = nil
+
+	err := s.reconcile(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"start"}, stateActions(fake, id))
+	assert.Empty(t, plugin.calls)
+}
+
+func TestRuntimeServer_reconcile_ContainerOfRestoredPodNetwork(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	plugin := &recordingNetworkPlugin{}
+	s.network = plugin
+
+	// the container is recorded as running, its network is the one of the container
+	id := testRunningContainer(t, s)
+
+	plugin.calls = nil
+	plugin.fail = map[string]bool{"pod status": true}
+
+	err := s.reconcile(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pod stopped", "pod started", "container started"}, plugin.calls)
+	assert.Equal(t, []string{"start"}, stateActions(fake, id))
+}
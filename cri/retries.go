@@ -0,0 +1,85 @@
+package cri
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/automaticserver/lxe/lxf"
+)
+
+// requestLocks serializes requests creating the same pod or container, like a request retried by kubelet after a
+// timeout while the first one is still running
+type requestLocks struct {
+	mu    sync.Mutex
+	locks map[string]*requestLock
+}
+
+type requestLock struct {
+	sync.Mutex
+	// users holding or waiting for the lock, it's removed when there are none
+	users int
+}
+
+func newRequestLocks() *requestLocks {
+	return &requestLocks{locks: map[string]*requestLock{}}
+}
+
+// lock the key and return the function to unlock it again
+func (r *requestLocks) lock(key string) func() {
+	r.mu.Lock()
+
+	l, has := r.locks[key]
+	if !has {
+		l = &requestLock{}
+		r.locks[key] = l
+	}
+
+	l.users++
+	r.mu.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		l.users--
+		if l.users == 0 {
+			delete(r.locks, key)
+		}
+	}
+}
+
+// sandboxRequestKey identifies the pod a RunPodSandbox request creates
+func sandboxRequestKey(uid string, attempt uint32) string {
+	return fmt.Sprintf("pod/%s/%d", uid, attempt)
+}
+
+// containerRequestKey identifies the container a CreateContainer request creates
+func containerRequestKey(sandboxID, name string, attempt uint32) string {
+	return fmt.Sprintf("container/%s/%s/%d", sandboxID, name, attempt)
+}
+
+// findSandbox returns the sandbox of the pod with the uid and attempt, nil if there's none
+func findSandbox(sandboxes []*lxf.Sandbox, uid string, attempt uint32) *lxf.Sandbox {
+	for _, sb := range sandboxes {
+		if sb.Metadata.UID == uid && sb.Metadata.Attempt == attempt {
+			return sb
+		}
+	}
+
+	return nil
+}
+
+// findContainer returns the container with the name and attempt, nil if there's none
+func findContainer(containers []*lxf.Container, name string, attempt uint32) *lxf.Container {
+	for _, c := range containers {
+		if c.Metadata.Name == name && c.Metadata.Attempt == attempt {
+			return c
+		}
+	}
+
+	return nil
+}
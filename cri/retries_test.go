@@ -0,0 +1,226 @@
+package cri
+
+import (
+	"sync"
+	"testing"
+
+	crifakes "github.com/automaticserver/lxe/fakes/lxe/lxf"
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestRequestLocks_Serialized(t *testing.T) {
+	t.Parallel()
+
+	locks := newRequestLocks()
+	running := 0
+	maxRunning := 0
+
+	var mu sync.Mutex
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock := locks.lock(sandboxRequestKey("uid", 0))
+			defer unlock()
+
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, 1, maxRunning)
+	assert.Empty(t, locks.locks)
+}
+
+func testSandboxMeta(id, uid string, attempt uint32) *lxf.Sandbox {
+	sb := testSandbox(id, lxf.SandboxReady)
+	sb.NetworkConfig.Mode = lxf.NetworkNone
+	sb.Metadata.UID = uid
+	sb.Metadata.Attempt = attempt
+
+	return sb
+}
+
+func TestFindSandbox(t *testing.T) {
+	t.Parallel()
+
+	sandboxes := []*lxf.Sandbox{
+		testSandboxMeta("first", "uid", 0),
+		testSandboxMeta("second", "uid", 1),
+		testSandboxMeta("other", "other", 1),
+	}
+
+	assert.Equal(t, "second", findSandbox(sandboxes, "uid", 1).ID)
+	assert.Nil(t, findSandbox(sandboxes, "uid", 2))
+}
+
+func TestFindContainer(t *testing.T) {
+	t.Parallel()
+
+	first := &lxf.Container{}
+	first.ID = "first"
+	first.Metadata = lxf.ContainerMetadata{Name: "foo", Attempt: 0}
+	second := &lxf.Container{}
+	second.ID = "second"
+	second.Metadata = lxf.ContainerMetadata{Name: "foo", Attempt: 1}
+
+	assert.Equal(t, "second", findContainer([]*lxf.Container{first, second}, "foo", 1).ID)
+	assert.Nil(t, findContainer([]*lxf.Container{first, second}, "bar", 0))
+}
+
+func TestRuntimeServer_existingSandbox_Ready(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	fake.ListSandboxesReturns([]*lxf.Sandbox{testSandboxMeta("foo", "uid", 1)}, nil)
+
+	s := RuntimeServer{lxf: fake}
+
+	sb, err := s.existingSandbox(ctx, "uid", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", sb.ID)
+
+	sb, err = s.existingSandbox(ctx, "uid", 2)
+	assert.NoError(t, err)
+	assert.Nil(t, sb)
+}
+
+func TestRuntimeServer_existingSandbox_NoUID(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	s := RuntimeServer{lxf: fake}
+
+	sb, err := s.existingSandbox(ctx, "", 0)
+	assert.NoError(t, err)
+	assert.Nil(t, sb)
+	assert.Equal(t, 0, fake.ListSandboxesCallCount())
+}
+
+func TestRuntimeServer_RunPodSandbox_Retried(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	s.network = &recordingNetworkPlugin{netns: "/proc/self/ns/net"}
+
+	first, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	second, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	assert.Equal(t, first.GetPodSandboxId(), second.GetPodSandboxId())
+	assert.Equal(t, 1, fake.CreateProfileCallCount())
+}
+
+func TestRuntimeServer_RunPodSandbox_RetriedNotReady(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	plugin := &recordingNetworkPlugin{netns: "/proc/self/ns/net"}
+	s.network = plugin
+
+	first, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	_, err = s.StopPodSandbox(ctx, &rtApi.StopPodSandboxRequest{PodSandboxId: first.GetPodSandboxId()})
+	require.NoError(t, err)
+
+	plugin.calls = nil
+
+	second, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.GetPodSandboxId(), second.GetPodSandboxId())
+	assert.Equal(t, []string{"pod stopped", "pod deleted", "pod created", "pod started"}, plugin.calls)
+	assert.NotContains(t, fake.profiles, first.GetPodSandboxId())
+	assert.Contains(t, fake.profiles, second.GetPodSandboxId())
+}
+
+func TestRuntimeServer_CreateContainer_Retried(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	first, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	second, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	assert.Equal(t, first.GetContainerId(), second.GetContainerId())
+	assert.Equal(t, 1, fake.CreateInstanceCallCount())
+}
+
+func TestRuntimeServer_CreateContainer_RetriedStarted(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	first, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	_, err = s.StartContainer(ctx, &rtApi.StartContainerRequest{ContainerId: first.GetContainerId()})
+	require.NoError(t, err)
+
+	// kubelet uses a new attempt for a new container, the started one must not be replaced
+	_, err = s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+
+	var annErr AnnotatedError
+
+	require.ErrorAs(t, err, &annErr)
+	assert.Equal(t, codes.AlreadyExists, annErr.Code)
+	assert.Equal(t, 1, fake.CreateInstanceCallCount())
+	assert.Contains(t, fake.instances, first.GetContainerId())
+	assert.Equal(t, []string{"start"}, stateActions(fake, first.GetContainerId()))
+}
+
+func TestRuntimeServer_CreateContainer_RetriedIncomplete(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	first, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	// the instance was created, but LXE was stopped before it recorded the container as created
+	fake.mu.Lock()
+	inst := fake.instances[first.GetContainerId()]
+	delete(inst.Config, "user.state")
+	fake.instances[first.GetContainerId()] = inst
+	fake.mu.Unlock()
+
+	second, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.GetContainerId(), second.GetContainerId())
+	assert.NotContains(t, fake.instances, first.GetContainerId())
+	assert.Contains(t, fake.instances, second.GetContainerId())
+}
@@ -2,6 +2,7 @@ package cri
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"path"
@@ -29,6 +30,12 @@ const (
 var (
 	ErrNotImplemented       = errors.New("not implemented")
 	ErrUnknownNetworkPlugin = errors.New("unknown network plugin")
+	ErrProcessNamespace     = errors.New("can't share process namespace")
+	ErrContainerStarted     = errors.New("container already started")
+
+	// defaultInteractiveCommand is run by containers with stdin but without a command, like the ones of `kubectl debug
+	// -it`. LXD images have no entrypoint which could be run instead.
+	defaultInteractiveCommand = []string{"/bin/sh"}
 )
 
 // RuntimeServer is the PoC implementation of the CRI RuntimeServer
@@ -39,6 +46,10 @@ type RuntimeServer struct {
 	lxdConfig *config.Config
 	criConfig *Config
 	network   network.Plugin
+	policy    *imagePolicy
+	locks     *requestLocks
+	handlers  *podHandlers
+	netStatus *networkStatuses
 }
 
 // NewRuntimeServer returns a new RuntimeServer backed by LXD
@@ -48,6 +59,9 @@ func NewRuntimeServer(criConfig *Config, lxf lxf.Client, network network.Plugin)
 	runtime := RuntimeServer{
 		criConfig: criConfig,
 		network:   network,
+		locks:     newRequestLocks(),
+		handlers:  newPodHandlers(),
+		netStatus: newNetworkStatuses(),
 	}
 
 	runtime.lxdConfig, err = config.LoadConfig(criConfig.LXDRemoteConfig)
@@ -57,6 +71,16 @@ func NewRuntimeServer(criConfig *Config, lxf lxf.Client, network network.Plugin)
 
 	runtime.lxf = lxf
 
+	runtime.policy, err = newImagePolicy(criConfig.ImagePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = criConfig.RuntimeHandlers.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return &runtime, nil
 }
 
@@ -66,7 +90,7 @@ func (s RuntimeServer) Version(ctx context.Context, req *rtApi.VersionRequest) (
 
 	// According to containerd CRI implementation RuntimeName=ShimName, RuntimeVersion=ShimVersion,
 	// RuntimeApiVersion=someAPIVersion. The actual runtime name and version is not present
-	info, err := s.lxf.GetRuntimeInfo()
+	info, err := s.lxf.GetRuntimeInfo(ctx)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get server environment")
 	}
@@ -91,13 +115,37 @@ func (s RuntimeServer) RunPodSandbox(ctx context.Context, req *rtApi.RunPodSandb
 	})
 	log.Info("run pod")
 
-	var err error
+	handler, err := s.criConfig.RuntimeHandlers.get(req.GetRuntimeHandler())
+	if err != nil {
+		return nil, AnnErr(log, codes.InvalidArgument, err, "unable to select runtime handler")
+	}
+
+	_, err = s.criConfig.AnnotationAllowlist.parseAnnotations(handler, req.GetConfig().GetAnnotations())
+	if err != nil {
+		return nil, AnnErr(log, codes.InvalidArgument, err, "annotation rejected")
+	}
+
+	// kubelet retries the request after a timeout, the pod created by an earlier request is returned or replaced
+	meta := req.GetConfig().GetMetadata()
+	unlock := s.locks.lock(sandboxRequestKey(meta.GetUid(), meta.GetAttempt()))
+	defer unlock()
+
+	existing, err := s.existingSandbox(ctx, meta.GetUid(), meta.GetAttempt())
+	if err != nil {
+		return nil, AnnErr(log, codes.Unknown, err, "unable to replace incomplete pod")
+	}
+
+	if existing != nil {
+		log.WithField("podid", existing.ID).Info("pod already created")
+		s.handlers.set(meta.GetUid(), existing.RuntimeHandler)
+
+		return &rtApi.RunPodSandboxResponse{PodSandboxId: existing.ID}, nil
+	}
 
 	sb := s.lxf.NewSandbox()
 
 	sb.Hostname = req.GetConfig().GetHostname()
 	sb.LogDirectory = req.GetConfig().GetLogDirectory()
-	meta := req.GetConfig().GetMetadata()
 	sb.Metadata = lxf.SandboxMetadata{
 		Attempt:   meta.GetAttempt(),
 		Name:      meta.GetName(),
@@ -106,6 +154,7 @@ func (s RuntimeServer) RunPodSandbox(ctx context.Context, req *rtApi.RunPodSandb
 	}
 	sb.Labels = req.GetConfig().GetLabels()
 	sb.Annotations = req.GetConfig().GetAnnotations()
+	sb.RuntimeHandler = req.GetRuntimeHandler()
 
 	if req.GetConfig().GetDnsConfig() != nil {
 		sb.NetworkConfig.Nameservers = req.GetConfig().GetDnsConfig().GetServers()
@@ -226,47 +275,36 @@ func (s RuntimeServer) RunPodSandbox(ctx context.Context, req *rtApi.RunPodSandb
 		}
 	}
 
-	err = sb.Apply()
+	// undo the created profile and network if a later step fails
+	tx := newTransaction(log)
+	defer tx.rollback()
+
+	err = sb.Apply(ctx)
+	// the pod gets its ID when the profile is created, which might have happened before a later error
+	if sb.ID != "" {
+		tx.done("create pod", func(ctx context.Context) error {
+			return ignoreNotFound(sb.Delete(ctx))
+		})
+	}
+
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "failed to create pod")
 	}
 
 	log = log.WithField("podid", sb.ID)
+	tx.log = log
 
 	// create network
-	if sb.NetworkConfig.Mode != lxf.NetworkHost { // nolint: nestif
-		podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "can't enter pod network context")
-		}
-
-		res, err := podNet.WhenCreated(ctx, &network.Properties{})
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "can't create pod network")
-		}
-
-		err = s.handleNetworkResult(sb, res)
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "unable to save pod network result")
-		}
-
-		// Since a PodSandbox is created "started", also fire started network
-		res, err = podNet.WhenStarted(ctx, &network.PropertiesRunning{
-			Properties: network.Properties{
-				Data: sb.NetworkConfig.ModeData,
-			},
-			Pid: 0, // if we had real 1:n pod:container we would add here the pid of the pod process
-		})
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "can't start pod network")
-		}
-
-		err = s.handleNetworkResult(sb, res)
+	if sb.NetworkConfig.Mode != lxf.NetworkHost {
+		err = s.setupPodNetwork(ctx, tx, sb)
 		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "unable to save start pod network result")
+			return nil, AnnErr(log, codes.Unknown, err, "unable to set up pod network")
 		}
 	}
 
+	tx.commit()
+	s.handlers.set(meta.GetUid(), sb.RuntimeHandler)
+
 	log.Info("run pod successful")
 
 	return &rtApi.RunPodSandboxResponse{PodSandboxId: sb.ID}, nil
@@ -281,7 +319,7 @@ func (s RuntimeServer) StopPodSandbox(ctx context.Context, req *rtApi.StopPodSan
 	log := log.WithContext(ctx).WithField("podid", req.GetPodSandboxId())
 	log.Info("stop pod")
 
-	sb, err := s.lxf.GetSandbox(req.GetPodSandboxId())
+	sb, err := s.lxf.GetSandbox(ctx, req.GetPodSandboxId())
 	if err != nil {
 		// If the sandbox can't be found, return no error with empty result
 		if lxf.IsNotFoundError(err) {
@@ -291,7 +329,7 @@ func (s RuntimeServer) StopPodSandbox(ctx context.Context, req *rtApi.StopPodSan
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get pod")
 	}
 
-	err = s.stopContainers(sb)
+	err = s.stopContainers(ctx, sb)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to stop containers")
 	}
@@ -312,7 +350,7 @@ func (s RuntimeServer) RemovePodSandbox(ctx context.Context, req *rtApi.RemovePo
 	log := log.WithContext(ctx).WithField("podid", req.GetPodSandboxId())
 	log.Info("remove pod")
 
-	sb, err := s.lxf.GetSandbox(req.GetPodSandboxId())
+	sb, err := s.lxf.GetSandbox(ctx, req.GetPodSandboxId())
 	if err != nil {
 		// If the sandbox can't be found, return no error with empty result
 		if lxf.IsNotFoundError(err) {
@@ -322,7 +360,7 @@ func (s RuntimeServer) RemovePodSandbox(ctx context.Context, req *rtApi.RemovePo
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get pod")
 	}
 
-	err = s.stopContainers(sb)
+	err = s.stopContainers(ctx, sb)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to stop containers")
 	}
@@ -337,6 +375,9 @@ func (s RuntimeServer) RemovePodSandbox(ctx context.Context, req *rtApi.RemovePo
 		return nil, AnnErr(log, codes.Unknown, err, "unable to delete pod")
 	}
 
+	s.handlers.delete(sb.Metadata.UID)
+	s.netStatus.forget(sb.ID)
+
 	log.Info("remove pod successful")
 
 	return &rtApi.RemovePodSandboxResponse{}, nil
@@ -346,7 +387,7 @@ func (s RuntimeServer) RemovePodSandbox(ctx context.Context, req *rtApi.RemovePo
 func (s RuntimeServer) PodSandboxStatus(ctx context.Context, req *rtApi.PodSandboxStatusRequest) (*rtApi.PodSandboxStatusResponse, error) {
 	log := log.WithContext(ctx).WithField("podid", req.GetPodSandboxId())
 
-	sb, err := s.lxf.GetSandbox(req.GetPodSandboxId())
+	sb, err := s.lxf.GetSandbox(ctx, req.GetPodSandboxId())
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil, AnnErr(log, codes.NotFound, err, "pod not found")
@@ -368,10 +409,10 @@ func (s RuntimeServer) PodSandboxStatus(ctx context.Context, req *rtApi.PodSandb
 			Labels:      sb.Labels,
 			Annotations: sb.Annotations,
 			CreatedAt:   sb.CreatedAt.UnixNano(),
-			State:       stateSandboxAsCri(sb.State),
 			Network: &rtApi.PodSandboxNetworkStatus{
 				Ip: "",
 			},
+			RuntimeHandler: sb.RuntimeHandler,
 		},
 	}
 
@@ -394,10 +435,7 @@ func (s RuntimeServer) PodSandboxStatus(ctx context.Context, req *rtApi.PodSandb
 		}
 	}
 
-	ip := s.getInetAddress(ctx, sb)
-	if ip != "" {
-		response.Status.Network.Ip = ip
-	}
+	response.Status.State, response.Status.Network.Ip = s.getSandboxState(ctx, sb)
 
 	return response, nil
 }
@@ -408,7 +446,60 @@ func (s RuntimeServer) PodSandboxStatus(ctx context.Context, req *rtApi.PodSandb
 // }
 
 // getInetAddress returns the ip address of the sandbox. empty string if nothing was found
-func (s RuntimeServer) getInetAddress(ctx context.Context, sb *lxf.Sandbox) string { // nolint: cyclop
+func (s RuntimeServer) getInetAddress(ctx context.Context, sb *lxf.Sandbox) string {
+	ip, _ := s.getNetworkStatus(ctx, sb)
+
+	return ip
+}
+
+// getSandboxState returns the state of the sandbox and its ip address. A ready sandbox whose network isn't ready, e.g.
+// because it got lost, is reported as not ready, so kubelet recreates it.
+func (s RuntimeServer) getSandboxState(ctx context.Context, sb *lxf.Sandbox) (rtApi.PodSandboxState, string) {
+	state := stateSandboxAsCri(sb.State)
+	if state != rtApi.PodSandboxState_SANDBOX_READY {
+		return state, s.getInetAddress(ctx, sb)
+	}
+
+	ip, ready := s.getNetworkStatus(ctx, sb)
+	if !ready || !s.podNetNamespaceAlive(ctx, sb) {
+		return rtApi.PodSandboxState_SANDBOX_NOTREADY, ip
+	}
+
+	return state, ip
+}
+
+// podNetNamespaceAlive reports whether the network namespace of a cni pod is alive. The namespace joined by the
+// containers is kept by a bind mount, which is gone after a reboot. Pods created before the namespace was kept have
+// their network in the containers, so it's only alive while one of them is running.
+func (s RuntimeServer) podNetNamespaceAlive(ctx context.Context, sb *lxf.Sandbox) bool {
+	if sb.NetworkConfig.Mode != lxf.NetworkCNI {
+		return true
+	}
+
+	if netns := podNetNamespace(sb); netns != "" {
+		return network.IsNetns(netns)
+	}
+
+	cl, err := sb.Containers(ctx)
+	if err != nil {
+		// don't let kubelet recreate the pod just because lxd didn't answer
+		log.WithContext(ctx).WithField("podid", sb.ID).WithError(err).Error("Couldn't list containers to find the pod network")
+
+		return true
+	}
+
+	for _, c := range cl {
+		if c.StateName == lxf.ContainerStateRunning {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getNetworkStatus returns the ip address of the sandbox, empty string if nothing was found, and whether its network
+// is ready. The network of a pod managed by a network plugin is ready if the plugin reports its status without error.
+func (s RuntimeServer) getNetworkStatus(ctx context.Context, sb *lxf.Sandbox) (string, bool) { // nolint: cyclop
 	log := log.WithContext(ctx).WithField("podid", sb.ID)
 
 	switch sb.NetworkConfig.Mode {
@@ -417,41 +508,32 @@ func (s RuntimeServer) getInetAddress(ctx context.Context, sb *lxf.Sandbox) stri
 		if err != nil {
 			log.WithError(err).Error("Couldn't choose host interface")
 
-			return ""
+			return "", true
 		}
 
-		return ip.String()
+		return ip.String(), true
 	case lxf.NetworkNone:
-		return ""
+		return "", true
 	case lxf.NetworkBridged:
 		fallthrough
 	case lxf.NetworkCNI:
-		podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
-		if err != nil {
-			log.WithError(err).Error("Couldn't get cni pod network")
-
-			return ""
-		}
-
-		status, err := podNet.Status(ctx, &network.PropertiesRunning{Properties: network.Properties{Data: sb.NetworkConfig.ModeData}, Pid: 0})
-		if err != nil {
-			log.WithError(err).Error("Couldn't get status of cni pod network")
-
-			return ""
+		ip, ready := s.getPodNetworkStatus(ctx, log, sb)
+		if !ready {
+			return "", false
 		}
 
-		if len(status.IPs) > 0 {
-			return status.IPs[0].String()
+		if ip != "" {
+			return ip, true
 		}
 	}
 
 	// If not yet returned, look into the containers interface list and select the address from the default interface
 	// TODO: is this still needed? Look into network.Bridge as well
-	cl, err := sb.Containers()
+	cl, err := sb.Containers(ctx)
 	if err != nil {
 		log.WithError(err).Error("Couldn't list containers while trying to get inet address")
 
-		return ""
+		return "", true
 	}
 
 	for _, c := range cl {
@@ -461,20 +543,50 @@ func (s RuntimeServer) getInetAddress(ctx context.Context, sb *lxf.Sandbox) stri
 		}
 
 		// get the ipv4 address of eth0
-		ip := c.GetInetAddress([]string{network.DefaultInterface})
+		ip := c.GetInetAddress(ctx, []string{network.DefaultInterface})
 		if ip != "" {
-			return ip
+			return ip, true
 		}
 	}
 
-	return ""
+	return "", true
+}
+
+// getPodNetworkStatus returns the ip address of the sandbox reported by the network plugin and whether its network is
+// ready. The status is cached for networkStatusTTL, as kubelet lists the pods every second.
+func (s RuntimeServer) getPodNetworkStatus(ctx context.Context, log *logrus.Entry, sb *lxf.Sandbox) (string, bool) {
+	if st, has := s.netStatus.get(sb.ID); has {
+		return st.ip, st.ready
+	}
+
+	podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
+	if err != nil {
+		log.WithError(err).Error("Couldn't get cni pod network")
+
+		return "", false
+	}
+
+	ip, ready := "", true
+
+	status, err := podNet.Status(ctx, &network.PropertiesRunning{Properties: network.Properties{Data: sb.NetworkConfig.ModeData}, Pid: 0})
+	if err != nil {
+		log.WithError(err).Warn("pod network is not ready")
+
+		ready = false
+	} else if len(status.IPs) > 0 {
+		ip = status.IPs[0].String()
+	}
+
+	s.netStatus.set(sb.ID, ip, ready)
+
+	return ip, ready
 }
 
 // ListPodSandbox returns a list of PodSandboxes.
 func (s RuntimeServer) ListPodSandbox(ctx context.Context, req *rtApi.ListPodSandboxRequest) (*rtApi.ListPodSandboxResponse, error) {
 	log := log.WithContext(ctx).WithField("filter", req.GetFilter().String())
 
-	sandboxes, err := s.lxf.ListSandboxes()
+	sandboxes, err := s.lxf.ListSandboxes(ctx)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to list pods")
 	}
@@ -482,19 +594,20 @@ func (s RuntimeServer) ListPodSandbox(ctx context.Context, req *rtApi.ListPodSan
 	response := &rtApi.ListPodSandboxResponse{}
 
 	for _, sb := range sandboxes {
-		if req.GetFilter() != nil {
-			filter := req.GetFilter()
-			if filter.GetId() != "" && filter.GetId() != sb.ID {
-				continue
-			}
+		filter := req.GetFilter()
+		if filter.GetId() != "" && filter.GetId() != sb.ID {
+			continue
+		}
 
-			if filter.GetState() != nil && filter.GetState().GetState() != stateSandboxAsCri(sb.State) {
-				continue
-			}
+		if !CompareFilterMap(sb.Labels, filter.GetLabelSelector()) {
+			continue
+		}
 
-			if !CompareFilterMap(sb.Labels, filter.GetLabelSelector()) {
-				continue
-			}
+		// the state is checked last, as it asks the network plugin. The ip isn't part of the list.
+		state, _ := s.getSandboxState(ctx, sb)
+
+		if filter.GetState() != nil && filter.GetState().GetState() != state {
+			continue
 		}
 
 		// TODO: toSandboxCRI()
@@ -507,9 +620,10 @@ func (s RuntimeServer) ListPodSandbox(ctx context.Context, req *rtApi.ListPodSan
 				Namespace: sb.Metadata.Namespace,
 				Uid:       sb.Metadata.UID,
 			},
-			State:       stateSandboxAsCri(sb.State),
-			Labels:      sb.Labels,
-			Annotations: sb.Annotations,
+			State:          state,
+			Labels:         sb.Labels,
+			Annotations:    sb.Annotations,
+			RuntimeHandler: sb.RuntimeHandler,
 		}
 		response.Items = append(response.Items, &pod)
 	}
@@ -533,16 +647,91 @@ func (s RuntimeServer) CreateContainer(ctx context.Context, req *rtApi.CreateCon
 	})
 	log.Info("create container")
 
-	img, err := s.lxf.GetImage(image)
+	sb, err := s.lxf.GetSandbox(ctx, req.GetPodSandboxId())
+	if err != nil {
+		return nil, AnnErr(log, codes.Unknown, err, "unable to find sandbox")
+	}
+
+	// the handler might have been removed from the config since the pod was created
+	handler, err := s.criConfig.RuntimeHandlers.get(sb.RuntimeHandler)
+	if err != nil {
+		return nil, AnnErr(log, codes.FailedPrecondition, err, "unable to select runtime handler")
+	}
+
+	img, err := s.lxf.GetImage(ctx, image)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "failed to find image hash")
 	}
 
-	c := s.lxf.NewContainer(req.GetPodSandboxId(), s.criConfig.LXDProfiles...)
+	img, err = s.imageVariant(ctx, log, image, img, lxf.InstanceType(handler.Type), sb.Metadata.Namespace)
+	if err != nil {
+		if errors.Is(err, ErrImagePolicy) {
+			return nil, AnnErr(log, codes.PermissionDenied, err, "image rejected by policy")
+		}
+
+		return nil, AnnErr(log, codes.Unknown, err, "failed to find image for instance type")
+	}
+
+	err = s.checkImagePolicy(ctx, req.GetPodSandboxId(), image, img)
+	if err != nil {
+		if errors.Is(err, ErrImagePolicy) {
+			return nil, AnnErr(log, codes.PermissionDenied, err, "image rejected by policy")
+		}
+
+		return nil, AnnErr(log, codes.Unknown, err, "unable to check image policy")
+	}
+
+	// the allowlist might have changed since the pod was created
+	podOpts, err := s.criConfig.AnnotationAllowlist.parseAnnotations(handler, sb.Annotations)
+	if err != nil {
+		return nil, AnnErr(log, codes.FailedPrecondition, err, "pod annotation rejected")
+	}
+
+	contOpts, err := s.criConfig.AnnotationAllowlist.parseAnnotations(handler, req.GetConfig().GetAnnotations())
+	if err != nil {
+		return nil, AnnErr(log, codes.InvalidArgument, err, "annotation rejected")
+	}
+
+	// kubelet retries the request after a timeout, the container created by an earlier request is returned or, if it's
+	// incomplete, replaced
+	meta := req.GetConfig().GetMetadata()
+	unlock := s.locks.lock(containerRequestKey(sb.ID, meta.GetName(), meta.GetAttempt()))
+	defer unlock()
+
+	existing, err := s.existingContainer(ctx, sb, meta.GetName(), meta.GetAttempt())
+	if err != nil {
+		if errors.Is(err, ErrContainerStarted) {
+			return nil, AnnErr(log, codes.AlreadyExists, err, "container of this attempt was already started")
+		}
+
+		return nil, AnnErr(log, codes.Unknown, err, "unable to replace incomplete container")
+	}
+
+	if existing != nil {
+		log.WithField("containerid", existing.ID).Info("container already created")
+
+		return &rtApi.CreateContainerResponse{ContainerId: existing.ID}, nil
+	}
+
+	c := s.lxf.NewContainer(req.GetPodSandboxId(), containerProfiles(s.criConfig.LXDProfiles, handler, podOpts, contOpts)...)
 	c.Image = img.Hash
 	c.Labels = req.GetConfig().GetLabels()
 	c.Annotations = req.GetConfig().GetAnnotations()
-	meta := req.GetConfig().GetMetadata()
+	// containers with a command run it to completion, unless overridden by the annotation
+	c.Command = append(append(c.Command, req.GetConfig().GetCommand()...), req.GetConfig().GetArgs()...)
+	c.WorkingDir = req.GetConfig().GetWorkingDir()
+	c.Interactive = req.GetConfig().GetStdin()
+
+	if len(c.Command) == 0 && c.Interactive {
+		c.Command = append(c.Command, defaultInteractiveCommand...)
+	}
+
+	c.Job = len(c.Command) > 0
+	c.TTY = req.GetConfig().GetTty()
+	handler.apply(c)
+	// the annotations of the container take precedence over the ones of the pod
+	podOpts.apply(c)
+	contOpts.apply(c)
 	c.Metadata = lxf.ContainerMetadata{
 		Attempt: meta.GetAttempt(),
 		Name:    meta.GetName(),
@@ -597,6 +786,14 @@ func (s RuntimeServer) CreateContainer(ctx context.Context, req *rtApi.CreateCon
 		c.CloudInitMetaData += "\n"
 	}
 
+	// debug containers targeting a container join its process namespace
+	if nso := req.GetConfig().GetLinux().GetSecurityContext().GetNamespaceOptions(); nso.GetPid() == rtApi.NamespaceMode_TARGET {
+		err = s.shareTargetPid(ctx, sb, c, nso.GetTargetId())
+		if err != nil {
+			return nil, AnnErr(log, codes.FailedPrecondition, err, "unable to share process namespace of target")
+		}
+	}
+
 	// process limits
 	resrc := req.GetConfig().GetLinux().GetResources()
 	if resrc != nil {
@@ -611,39 +808,32 @@ func (s RuntimeServer) CreateContainer(ctx context.Context, req *rtApi.CreateCon
 		c.Resources.Memory.Limit = &resrc.MemoryLimitInBytes
 	}
 
-	err = c.Apply()
-	if err != nil {
-		return nil, AnnErr(log, codes.Unknown, err, "unable to create container")
+	// undo the created container and network if a later step fails
+	tx := newTransaction(log)
+	defer tx.rollback()
+
+	err = c.Apply(ctx)
+	// the container gets its ID when the instance is created, which might have happened before a later error
+	if c.ID != "" {
+		tx.done("create container", func(ctx context.Context) error {
+			return ignoreNotFound(c.Delete(ctx))
+		})
 	}
 
-	sb, err := c.Sandbox()
 	if err != nil {
-		return nil, AnnErr(log, codes.Unknown, err, "unable to find sandbox")
+		return nil, AnnErr(log, codes.Unknown, err, "unable to create container")
 	}
 
 	// create network
 	if sb.NetworkConfig.Mode != lxf.NetworkHost {
-		podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "can't enter pod network context")
-		}
-
-		contNet, err := podNet.ContainerNetwork(c.ID, c.Annotations)
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "can't enter container network context")
-		}
-
-		res, err := contNet.WhenCreated(ctx, &network.Properties{})
+		err = s.createContainerNetwork(ctx, tx, sb, c)
 		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "can't create container network")
-		}
-
-		err = s.handleNetworkResult(sb, res)
-		if err != nil {
-			return nil, AnnErr(log, codes.Unknown, err, "unable to save create container network result")
+			return nil, AnnErr(log, codes.Unknown, err, "unable to create container network")
 		}
 	}
 
+	tx.commit()
+
 	log.Info("create container successful")
 
 	return &rtApi.CreateContainerResponse{ContainerId: c.ID}, nil
@@ -654,12 +844,12 @@ func (s RuntimeServer) StartContainer(ctx context.Context, req *rtApi.StartConta
 	log := log.WithContext(ctx).WithField("containerid", req.GetContainerId())
 	log.Info("start container")
 
-	c, err := s.lxf.GetContainer(req.GetContainerId())
+	c, err := s.lxf.GetContainer(ctx, req.GetContainerId())
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get container")
 	}
 
-	err = c.Start()
+	err = c.Start(ctx)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to start container")
 	}
@@ -675,7 +865,7 @@ func (s RuntimeServer) StopContainer(ctx context.Context, req *rtApi.StopContain
 	log := log.WithContext(ctx).WithField("containerid", req.GetContainerId())
 	log.Info("stop container")
 
-	c, err := s.lxf.GetContainer(req.GetContainerId())
+	c, err := s.lxf.GetContainer(ctx, req.GetContainerId())
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return &rtApi.StopContainerResponse{}, nil
@@ -684,7 +874,7 @@ func (s RuntimeServer) StopContainer(ctx context.Context, req *rtApi.StopContain
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get container")
 	}
 
-	err = s.stopContainer(c, int(req.Timeout))
+	err = s.stopContainer(ctx, c, int(req.Timeout))
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to stop container")
 	}
@@ -700,7 +890,7 @@ func (s RuntimeServer) RemoveContainer(ctx context.Context, req *rtApi.RemoveCon
 	log := log.WithContext(ctx).WithField("containerid", req.GetContainerId())
 	log.Info("remove container")
 
-	c, err := s.lxf.GetContainer(req.GetContainerId())
+	c, err := s.lxf.GetContainer(ctx, req.GetContainerId())
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return &rtApi.RemoveContainerResponse{}, nil
@@ -725,7 +915,7 @@ func (s RuntimeServer) ListContainers(ctx context.Context, req *rtApi.ListContai
 
 	response := &rtApi.ListContainersResponse{}
 
-	cl, err := s.lxf.ListContainers()
+	cl, err := s.lxf.ListContainers(ctx)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get container list")
 	}
@@ -760,7 +950,7 @@ func (s RuntimeServer) ListContainers(ctx context.Context, req *rtApi.ListContai
 func (s RuntimeServer) ContainerStatus(ctx context.Context, req *rtApi.ContainerStatusRequest) (*rtApi.ContainerStatusResponse, error) {
 	log := log.WithContext(ctx).WithField("containerid", req.GetContainerId())
 
-	ct, err := s.lxf.GetContainer(req.GetContainerId())
+	ct, err := s.lxf.GetContainer(ctx, req.GetContainerId())
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil, AnnErr(log, codes.NotFound, err, "container not found")
@@ -800,7 +990,7 @@ func (s RuntimeServer) ExecSync(ctx context.Context, req *rtApi.ExecSyncRequest)
 	stderr := bytes.NewBuffer(nil)
 	stderrW := ioutils.WriteCloserWrapper(stderr)
 
-	code, err := s.lxf.Exec(req.GetContainerId(), req.GetCmd(), stdinR, stdoutW, stderrW, false, false, req.GetTimeout(), nil)
+	code, err := s.lxf.Exec(ctx, req.GetContainerId(), req.GetCmd(), stdinR, stdoutW, stderrW, false, false, req.GetTimeout(), nil)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to exec")
 	}
@@ -830,9 +1020,26 @@ func (s RuntimeServer) Exec(ctx context.Context, req *rtApi.ExecRequest) (*rtApi
 	return resp, nil
 }
 
-// Attach prepares a streaming endpoint to attach to a running container.
+// Attach prepares a streaming endpoint to attach to a running container. Only interactive job containers, like debug
+// containers, can be attached to.
 func (s RuntimeServer) Attach(ctx context.Context, req *rtApi.AttachRequest) (*rtApi.AttachResponse, error) {
-	return nil, SilErr(log, codes.Unimplemented, ErrNotImplemented, "")
+	log := log.WithContext(ctx).WithField("containerid", req.GetContainerId())
+
+	c, err := s.lxf.GetContainer(ctx, req.GetContainerId())
+	if err != nil {
+		return nil, AnnErr(log, codes.Unknown, err, "unable to get container")
+	}
+
+	if !c.Job || !c.Interactive {
+		return nil, AnnErr(log, codes.FailedPrecondition, lxf.ErrNotAttachable, "unable to attach")
+	}
+
+	resp, err := s.stream.streamServer.GetAttach(req)
+	if err != nil {
+		return nil, AnnErr(log, codes.Unknown, err, "unable to get attach stream")
+	}
+
+	return resp, nil
 }
 
 // PortForward prepares a streaming endpoint to forward ports from a PodSandbox.
@@ -854,12 +1061,12 @@ func (s RuntimeServer) PortForward(ctx context.Context, req *rtApi.PortForwardRe
 func (s RuntimeServer) ContainerStats(ctx context.Context, req *rtApi.ContainerStatsRequest) (*rtApi.ContainerStatsResponse, error) {
 	log := log.WithContext(ctx).WithField("containerid", req.GetContainerId())
 
-	cntStat, err := s.lxf.GetContainer(req.GetContainerId())
+	cntStat, err := s.lxf.GetContainer(ctx, req.GetContainerId())
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get container")
 	}
 
-	stats, err := toCriStats(cntStat)
+	stats, err := toCriStats(ctx, cntStat)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to get stats")
 	}
@@ -876,12 +1083,12 @@ func (s RuntimeServer) ListContainerStats(ctx context.Context, req *rtApi.ListCo
 	if req.GetFilter() != nil && req.GetFilter().GetId() != "" {
 		log = log.WithField("containerid", req.GetFilter().GetId())
 
-		c, err := s.lxf.GetContainer(req.Filter.Id)
+		c, err := s.lxf.GetContainer(ctx, req.Filter.Id)
 		if err != nil {
 			return nil, AnnErr(log, codes.Unknown, err, "unable to get container")
 		}
 
-		st, err := toCriStats(c)
+		st, err := toCriStats(ctx, c)
 		if err != nil {
 			return nil, AnnErr(log, codes.Unknown, err, "unable to get stats")
 		}
@@ -891,7 +1098,7 @@ func (s RuntimeServer) ListContainerStats(ctx context.Context, req *rtApi.ListCo
 		return response, nil
 	}
 
-	cts, err := s.lxf.ListContainers()
+	cts, err := s.lxf.ListContainers(ctx)
 	if err != nil {
 		return nil, AnnErr(log, codes.Unknown, err, "unable to list containers")
 	}
@@ -899,7 +1106,7 @@ func (s RuntimeServer) ListContainerStats(ctx context.Context, req *rtApi.ListCo
 	for _, c := range cts {
 		log = log.WithField("containerid", c.ID)
 
-		st, err := toCriStats(c)
+		st, err := toCriStats(ctx, c)
 		if err != nil {
 			return nil, AnnErr(log, codes.Unknown, err, "unable to get stats")
 		}
@@ -940,5 +1147,15 @@ func (s RuntimeServer) Status(ctx context.Context, req *rtApi.StatusRequest) (*r
 		},
 	}
 
+	// The runtime handlers are part of the verbose info, as the StatusResponse of this CRI version has no field for them
+	if req.GetVerbose() {
+		info, err := json.Marshal(verboseStatusInfo{RuntimeHandlers: s.criConfig.RuntimeHandlers.features()})
+		if err != nil {
+			return nil, AnnErr(log.WithContext(ctx), codes.Unknown, err, "failed to marshal verbose status info")
+		}
+
+		response.Info = map[string]string{"info": string(info)}
+	}
+
 	return response, nil
 }
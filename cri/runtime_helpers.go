@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/automaticserver/lxe/lxf"
@@ -35,6 +36,16 @@ func toCriStatusResponse(c *lxf.Container) *rtApi.ContainerStatusResponse {
 		Mounts:      []*rtApi.Mount{},
 	}
 
+	// only job containers know the exit code of their command
+	if c.Job && c.StateName == lxf.ContainerStateExited {
+		status.ExitCode = c.ExitCode
+
+		status.Reason = "Completed"
+		if c.ExitCode != 0 {
+			status.Reason = "Error"
+		}
+	}
+
 	for _, dev := range c.Devices {
 		switch d := dev.(type) {
 		case *device.Block:
@@ -62,8 +73,8 @@ func toCriStatusResponse(c *lxf.Container) *rtApi.ContainerStatusResponse {
 	}
 }
 
-func toCriStats(c *lxf.Container) (*rtApi.ContainerStats, error) {
-	st, err := c.State()
+func toCriStats(ctx context.Context, c *lxf.Container) (*rtApi.ContainerStats, error) {
+	st, err := c.State(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -169,9 +180,35 @@ var (
 	ErrDetectDefault = errors.New("unable to detect default")
 )
 
-// setDefaultLXDSocketPath tries to find and set the lxd socket if missing
+// lxdConnection returns how to connect to LXD
+func lxdConnection(cfg *Config) lxf.ConnectionConfig {
+	conn := lxf.ConnectionConfig{
+		Socket:            cfg.LXDSocket,
+		TLSClientCert:     cfg.LXDTLSClientCert,
+		TLSClientKey:      cfg.LXDTLSClientKey,
+		TLSCA:             cfg.LXDTLSCA,
+		Project:           cfg.LXDProject,
+		Profiles:          cfg.RuntimeHandlers.allProfiles(cfg.LXDProfiles),
+		NamespaceProjects: cfg.LXDNamespaceProjects,
+		Cache: lxf.CacheConfig{
+			Enabled: cfg.LXDCache,
+			Resync:  cfg.LXDCacheResync,
+		},
+		OperationTimeouts: cfg.LXDOperationTimeouts,
+	}
+
+	if strings.HasPrefix(cfg.LXDRemote, "https://") {
+		conn.URL = cfg.LXDRemote
+	} else {
+		conn.Remote = cfg.LXDRemote
+	}
+
+	return conn
+}
+
+// setDefaultLXDSocketPath tries to find and set the lxd socket if missing. Not needed if a remote LXD is used.
 func setDefaultLXDSocketPath(cfg *Config) error {
-	if cfg.LXDSocket != "" {
+	if cfg.LXDSocket != "" || cfg.LXDRemote != "" {
 		return nil
 	}
 
@@ -236,14 +273,14 @@ func setDefaultLXDConfigPath(cfg *Config) error {
 
 const defaultTimeoutContainerStop = 30
 
-func (s RuntimeServer) stopContainers(sb *lxf.Sandbox) error {
-	cl, err := sb.Containers()
+func (s RuntimeServer) stopContainers(ctx context.Context, sb *lxf.Sandbox) error {
+	cl, err := sb.Containers(ctx)
 	if err != nil {
 		return err
 	}
 
 	for _, c := range cl {
-		err := s.stopContainer(c, defaultTimeoutContainerStop)
+		err := s.stopContainer(ctx, c, defaultTimeoutContainerStop)
 		if err != nil {
 			return err
 		}
@@ -252,13 +289,13 @@ func (s RuntimeServer) stopContainers(sb *lxf.Sandbox) error {
 	return nil
 }
 
-func (s RuntimeServer) stopContainer(c *lxf.Container, timeout int) error {
+func (s RuntimeServer) stopContainer(ctx context.Context, c *lxf.Container, timeout int) error {
 	// if container is not running, no stopping needed
 	if c.StateName != lxf.ContainerStateRunning {
 		return nil
 	}
 
-	err := c.Stop(timeout)
+	err := c.Stop(ctx, timeout)
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil
@@ -271,7 +308,7 @@ func (s RuntimeServer) stopContainer(c *lxf.Container, timeout int) error {
 }
 
 func (s RuntimeServer) deleteContainers(ctx context.Context, sb *lxf.Sandbox) error {
-	cl, err := sb.Containers()
+	cl, err := sb.Containers(ctx)
 	if err != nil {
 		return err
 	}
@@ -288,7 +325,7 @@ func (s RuntimeServer) deleteContainers(ctx context.Context, sb *lxf.Sandbox) er
 
 // Delete container ignoring not found errors and cleaning up network
 func (s RuntimeServer) deleteContainer(ctx context.Context, c *lxf.Container) error {
-	err := c.Delete()
+	err := c.Delete(ctx)
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil
@@ -297,7 +334,7 @@ func (s RuntimeServer) deleteContainer(ctx context.Context, c *lxf.Container) er
 		return err
 	}
 
-	sb, err := c.Sandbox()
+	sb, err := c.Sandbox(ctx)
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil
@@ -322,7 +359,7 @@ func (s RuntimeServer) deleteContainer(ctx context.Context, c *lxf.Container) er
 
 // Stop sandbox and network ignoring not found errors
 func (s RuntimeServer) stopSandbox(ctx context.Context, sb *lxf.Sandbox) error {
-	err := sb.Stop()
+	err := sb.Stop(ctx)
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil
@@ -337,6 +374,8 @@ func (s RuntimeServer) stopSandbox(ctx context.Context, sb *lxf.Sandbox) error {
 		if err == nil { // force cleanup, we don't care about error, but only enter if there's no error
 			_ = netw.WhenStopped(ctx, &network.Properties{Data: sb.NetworkConfig.ModeData})
 		}
+
+		s.netStatus.forget(sb.ID)
 	}
 
 	return nil
@@ -344,7 +383,7 @@ func (s RuntimeServer) stopSandbox(ctx context.Context, sb *lxf.Sandbox) error {
 
 // Delete sandbox and network ignoring not found errors
 func (s RuntimeServer) deleteSandbox(ctx context.Context, sb *lxf.Sandbox) error {
-	err := sb.Delete()
+	err := sb.Delete(ctx)
 	if err != nil {
 		if lxf.IsNotFoundError(err) {
 			return nil
@@ -365,17 +404,173 @@ func (s RuntimeServer) deleteSandbox(ctx context.Context, sb *lxf.Sandbox) error
 	return nil
 }
 
+// setupPodNetwork creates and starts the network of a new pod. Every completed step is recorded in tx to be undone.
+func (s RuntimeServer) setupPodNetwork(ctx context.Context, tx *transaction, sb *lxf.Sandbox) error {
+	podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
+	if err != nil {
+		return fmt.Errorf("can't enter pod network context: %w", err)
+	}
+
+	res, err := podNet.WhenCreated(ctx, &network.Properties{})
+	if err != nil {
+		return fmt.Errorf("can't create pod network: %w", err)
+	}
+
+	tx.done("create pod network", func(ctx context.Context) error {
+		return podNet.WhenDeleted(ctx, &network.Properties{Data: sb.NetworkConfig.ModeData})
+	})
+
+	err = s.handleNetworkResult(ctx, sb, res)
+	if err != nil {
+		return fmt.Errorf("unable to save pod network result: %w", err)
+	}
+
+	// Since a PodSandbox is created "started", also fire started network
+	res, err = podNet.WhenStarted(ctx, &network.PropertiesRunning{
+		Properties: network.Properties{
+			Data: sb.NetworkConfig.ModeData,
+		},
+		Pid: 0, // the pod has no process, its network namespace is kept by the network plugin
+	})
+	if err != nil {
+		return fmt.Errorf("can't start pod network: %w", err)
+	}
+
+	tx.done("start pod network", func(ctx context.Context) error {
+		return podNet.WhenStopped(ctx, &network.Properties{Data: sb.NetworkConfig.ModeData})
+	})
+
+	err = s.handleNetworkResult(ctx, sb, res)
+	if err != nil {
+		return fmt.Errorf("unable to save start pod network result: %w", err)
+	}
+
+	return nil
+}
+
+// createContainerNetwork creates the network of a new container. Every completed step is recorded in tx to be undone.
+func (s RuntimeServer) createContainerNetwork(ctx context.Context, tx *transaction, sb *lxf.Sandbox, c *lxf.Container) error {
+	podNet, err := s.network.PodNetwork(sb.ID, sb.Annotations)
+	if err != nil {
+		return fmt.Errorf("can't enter pod network context: %w", err)
+	}
+
+	contNet, err := podNet.ContainerNetwork(c.ID, c.Annotations)
+	if err != nil {
+		return fmt.Errorf("can't enter container network context: %w", err)
+	}
+
+	res, err := contNet.WhenCreated(ctx, &network.Properties{})
+	if err != nil {
+		return fmt.Errorf("can't create container network: %w", err)
+	}
+
+	tx.done("create container network", func(ctx context.Context) error {
+		return contNet.WhenDeleted(ctx, &network.Properties{Data: sb.NetworkConfig.ModeData})
+	})
+
+	err = s.handleNetworkResult(ctx, sb, res)
+	if err != nil {
+		return fmt.Errorf("unable to save create container network result: %w", err)
+	}
+
+	return nil
+}
+
+// ignoreNotFound returns nil if err reports a missing object
+func ignoreNotFound(err error) error {
+	if lxf.IsNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+// existingSandbox returns the complete sandbox of the pod with the uid and attempt, nil if there's none. A sandbox
+// which isn't ready, like when LXE stopped while creating it, is removed to be created again.
+func (s RuntimeServer) existingSandbox(ctx context.Context, uid string, attempt uint32) (*lxf.Sandbox, error) {
+	if uid == "" {
+		return nil, nil // nolint: nilnil
+	}
+
+	sandboxes, err := s.lxf.ListSandboxes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sb := findSandbox(sandboxes, uid, attempt)
+	if sb == nil {
+		return nil, nil // nolint: nilnil
+	}
+
+	state, _ := s.getSandboxState(ctx, sb)
+	if state == rtApi.PodSandboxState_SANDBOX_READY {
+		return sb, nil
+	}
+
+	log.WithContext(ctx).WithField("podid", sb.ID).Warn("removing incomplete pod")
+
+	err = s.stopContainers(ctx, sb)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.deleteContainers(ctx, sb)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.stopSandbox(ctx, sb)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, s.deleteSandbox(ctx, sb)
+}
+
+// existingContainer returns the container of the sandbox with the name and attempt, nil if there's none. A retried
+// request expects a container which was never started. A container which was started already, running or exited, is
+// kept and ErrContainerStarted returned, as kubelet uses a new attempt for a new container. Any other container is
+// incomplete, e.g. because LXE was stopped while creating it, and removed to be created again.
+func (s RuntimeServer) existingContainer(ctx context.Context, sb *lxf.Sandbox, name string, attempt uint32) (*lxf.Container, error) {
+	cl, err := sb.Containers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := findContainer(cl, name, attempt)
+	if c == nil {
+		return nil, nil // nolint: nilnil
+	}
+
+	switch {
+	case c.StateName == lxf.ContainerStateCreated:
+		return c, nil
+	case c.StateName == lxf.ContainerStateRunning, c.StateName == lxf.ContainerStateExited && c.StartedAt.After(time.Unix(0, 0)):
+		return nil, fmt.Errorf("%w: %s", ErrContainerStarted, c.ID)
+	}
+
+	log.WithContext(ctx).WithField("containerid", c.ID).Warn("removing incomplete container")
+
+	err = s.stopContainer(ctx, c, defaultTimeoutContainerStop)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, s.deleteContainer(ctx, c)
+}
+
 var NetworkSetupTimeout = 30 * time.Second
 
 // ContainerStarted implements lxf.EventHandler interface
-func (s RuntimeServer) ContainerStarted(c *lxf.Container) error {
-	sb, err := c.Sandbox()
+func (s RuntimeServer) ContainerStarted(ctx context.Context, c *lxf.Container) error {
+	sb, err := c.Sandbox(ctx)
 	if err != nil {
 		return err
 	}
 
 	if sb.NetworkConfig.Mode != lxf.NetworkHost { // nolint: nestif
-		st, err := c.State()
+		st, err := c.State(ctx)
 		if err != nil {
 			return err
 		}
@@ -390,9 +585,10 @@ func (s RuntimeServer) ContainerStarted(c *lxf.Container) error {
 			return fmt.Errorf("can't enter container network context: %w", err)
 		}
 
-		ctx, _ := context.WithTimeout(context.Background(), NetworkSetupTimeout)
+		netCtx, cancel := context.WithTimeout(ctx, NetworkSetupTimeout)
+		defer cancel()
 
-		res, err := contNet.WhenStarted(ctx, &network.PropertiesRunning{
+		res, err := contNet.WhenStarted(netCtx, &network.PropertiesRunning{
 			Properties: network.Properties{
 				Data: sb.NetworkConfig.ModeData,
 			},
@@ -402,7 +598,7 @@ func (s RuntimeServer) ContainerStarted(c *lxf.Container) error {
 			return fmt.Errorf("can't start container network: %w", err)
 		}
 
-		err = s.handleNetworkResult(sb, res)
+		err = s.handleNetworkResult(ctx, sb, res)
 		if err != nil {
 			return fmt.Errorf("unable to save create container network result: %w", err)
 		}
@@ -412,8 +608,8 @@ func (s RuntimeServer) ContainerStarted(c *lxf.Container) error {
 }
 
 // ContainerStopped implements lxf.EventHandler interface
-func (s *RuntimeServer) ContainerStopped(c *lxf.Container) error {
-	sb, err := c.Sandbox()
+func (s *RuntimeServer) ContainerStopped(ctx context.Context, c *lxf.Container) error {
+	sb, err := c.Sandbox(ctx)
 	if err != nil {
 		return err
 	}
@@ -424,8 +620,10 @@ func (s *RuntimeServer) ContainerStopped(c *lxf.Container) error {
 		if err == nil { // force cleanup, we don't care about error, but only enter if there's no error
 			contNet, err := podNet.ContainerNetwork(c.ID, c.Annotations)
 			if err == nil { // dito
-				ctx, _ := context.WithTimeout(context.Background(), NetworkSetupTimeout)
-				_ = contNet.WhenStopped(ctx, &network.Properties{Data: sb.NetworkConfig.ModeData})
+				netCtx, cancel := context.WithTimeout(ctx, NetworkSetupTimeout)
+				defer cancel()
+
+				_ = contNet.WhenStopped(netCtx, &network.Properties{Data: sb.NetworkConfig.ModeData})
 			}
 		}
 	}
@@ -433,21 +631,142 @@ func (s *RuntimeServer) ContainerStopped(c *lxf.Container) error {
 	return nil
 }
 
-func (s *RuntimeServer) handleNetworkResult(sb *lxf.Sandbox, res *network.Result) error {
-	if res != nil {
-		if len(res.Data) > 0 {
-			sb.NetworkConfig.ModeData = res.Data
-		}
+// shareNetPrefix is the raw.lxc line of a pod profile which lets its containers join the network namespace of the pod
+const shareNetPrefix = "lxc.namespace.share.net = "
 
-		for _, n := range res.Nics {
-			n := n
-			sb.Devices.Upsert(&n)
+// podNetNamespace returns the path of the network namespace joined by the containers of the pod, empty if there is none
+func podNetNamespace(sb *lxf.Sandbox) string {
+	for _, line := range strings.Split(sb.Config["raw.lxc"], "\n") {
+		if strings.HasPrefix(line, shareNetPrefix) {
+			return strings.TrimPrefix(line, shareNetPrefix)
 		}
+	}
+
+	return ""
+}
+
+func (s *RuntimeServer) handleNetworkResult(ctx context.Context, sb *lxf.Sandbox, res *network.Result) error {
+	// the status of the network changes with the result
+	s.netStatus.forget(sb.ID)
+
+	if res != nil {
+		// the sandbox might have been changed concurrently by lifecycle events, so apply the result on the latest state
+		return sb.Update(ctx, func(sb *lxf.Sandbox) error {
+			if len(res.Data) > 0 {
+				sb.NetworkConfig.ModeData = res.Data
+			}
+
+			for _, n := range res.Nics {
+				n := n
+				sb.Devices.Upsert(&n)
+			}
+
+			sb.CloudInitNetworkConfigEntries = append(sb.CloudInitNetworkConfigEntries, res.NetworkConfigEntries...)
+
+			if res.NetNamespace != "" {
+				shareNet := shareNetPrefix + res.NetNamespace
+				if !strings.Contains(sb.Config["raw.lxc"], shareNet) {
+					lxf.AppendIfSet(&sb.Config, "raw.lxc", shareNet)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return nil
+}
+
+// shareTargetPid lets the container join the process namespace of the target container, which must be running. The
+// namespace is only shared with the process of the target at the time, not after it is restarted.
+func (s RuntimeServer) shareTargetPid(ctx context.Context, sb *lxf.Sandbox, c *lxf.Container, targetID string) error {
+	target, err := s.lxf.GetContainer(ctx, targetID)
+	if err != nil {
+		return err
+	}
 
-		sb.CloudInitNetworkConfigEntries = append(sb.CloudInitNetworkConfigEntries, res.NetworkConfigEntries...)
+	if target.SandboxID() != sb.ID {
+		return fmt.Errorf("%w: target %s is not in pod %s", ErrProcessNamespace, targetID, sb.ID)
+	}
 
-		return sb.Apply()
+	if target.Type == lxf.InstanceTypeVirtualMachine || c.Type == lxf.InstanceTypeVirtualMachine {
+		return fmt.Errorf("%w: virtual machines can't share processes", ErrProcessNamespace)
 	}
 
+	st, err := target.State(ctx)
+	if err != nil {
+		return err
+	}
+
+	if target.StateName != lxf.ContainerStateRunning || st.Pid <= 0 {
+		return fmt.Errorf("%w: target %s is not running", ErrProcessNamespace, targetID)
+	}
+
+	// the raw.lxc of the container replaces the one of the sandbox, which must therefore be kept
+	rawLXC, has := c.Config["raw.lxc"]
+	if !has {
+		rawLXC = sb.Config["raw.lxc"]
+	}
+
+	c.Config["raw.lxc"] = rawLXC
+	lxf.AppendIfSet(&c.Config, "raw.lxc", fmt.Sprintf("lxc.namespace.share.pid = /proc/%d/ns/pid", st.Pid))
+
 	return nil
 }
+
+// networkStatusTTL is how long the status of a pod network reported by the network plugin is used
+const networkStatusTTL = 5 * time.Second
+
+// networkStatuses caches the status of the pod networks reported by the network plugin, so the list of pods doesn't
+// ask the network plugin for every ready pod
+type networkStatuses struct {
+	mu       sync.Mutex
+	statuses map[string]networkStatus
+}
+
+type networkStatus struct {
+	ip    string
+	ready bool
+	at    time.Time
+}
+
+func newNetworkStatuses() *networkStatuses {
+	return &networkStatuses{statuses: map[string]networkStatus{}}
+}
+
+// get returns the status of the pod network if it was reported within networkStatusTTL
+func (n *networkStatuses) get(id string) (networkStatus, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	st, has := n.statuses[id]
+	if !has || time.Since(st.at) >= networkStatusTTL {
+		return networkStatus{}, false
+	}
+
+	return st, true
+}
+
+// set remembers the status of the pod network, expired statuses are dropped
+func (n *networkStatuses) set(id, ip string, ready bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := time.Now()
+
+	for k, st := range n.statuses {
+		if now.Sub(st.at) >= networkStatusTTL {
+			delete(n.statuses, k)
+		}
+	}
+
+	n.statuses[id] = networkStatus{ip: ip, ready: ready, at: now}
+}
+
+// forget drops the status of the pod network, e.g. when it's started or stopped
+func (n *networkStatuses) forget(id string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.statuses, id)
+}
@@ -0,0 +1,402 @@
+package cri
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+
+	crifakes "github.com/automaticserver/lxe/fakes/lxe/lxf"
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/automaticserver/lxe/network"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestRuntimeServer_shareTargetPid_Rejected(t *testing.T) {
+	t.Parallel()
+
+	sb := &lxf.Sandbox{}
+	sb.ID = "pod"
+
+	tests := []struct {
+		name   string
+		target *lxf.Container
+		typ    lxf.InstanceType
+	}{
+		{"other pod", &lxf.Container{Profiles: []string{"default", "other"}}, lxf.InstanceTypeContainer},
+		{"target vm", &lxf.Container{Profiles: []string{"pod"}, Type: lxf.InstanceTypeVirtualMachine}, lxf.InstanceTypeContainer},
+		{"debug vm", &lxf.Container{Profiles: []string{"pod"}, Type: lxf.InstanceTypeContainer}, lxf.InstanceTypeVirtualMachine},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &crifakes.FakeClient{}
+			fake.GetContainerReturns(tt.target, nil)
+
+			s := RuntimeServer{lxf: fake}
+			c := &lxf.Container{Type: tt.typ}
+			c.Config = map[string]string{}
+
+			err := s.shareTargetPid(ctx, sb, c, "target")
+			assert.ErrorIs(t, err, ErrProcessNamespace)
+			assert.NotContains(t, c.Config, "raw.lxc")
+		})
+	}
+}
+
+func TestRuntimeServer_Attach_NotInteractive(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	fake.GetContainerReturns(&lxf.Container{Job: true}, nil)
+
+	s := RuntimeServer{lxf: fake}
+
+	_, err := s.Attach(ctx, &rtApi.AttachRequest{ContainerId: "foo", Stdin: true})
+
+	var annErr AnnotatedError
+
+	assert.ErrorAs(t, err, &annErr)
+	assert.Equal(t, codes.FailedPrecondition, annErr.Code)
+	assert.ErrorIs(t, annErr.Err, lxf.ErrNotAttachable)
+}
+
+// testNetworkPlugin returns pod networks whose status fails with err
+type testNetworkPlugin struct {
+	network.Plugin
+	err error
+	// statuses counts the status requests of the pod networks
+	statuses int
+}
+
+func (p *testNetworkPlugin) PodNetwork(_ string, _ map[string]string) (network.PodNetwork, error) {
+	return &testPodNetwork{plugin: p}, nil
+}
+
+type testPodNetwork struct {
+	network.PodNetwork
+	plugin *testNetworkPlugin
+}
+
+func (n *testPodNetwork) Status(_ context.Context, _ *network.PropertiesRunning) (*network.Status, error) {
+	n.plugin.statuses++
+
+	if n.plugin.err != nil {
+		return nil, n.plugin.err
+	}
+
+	return &network.Status{IPs: []net.IP{net.ParseIP("10.22.0.64")}}, nil
+}
+
+// testSandbox returns a cni sandbox, whose containers join the network namespace of the test process
+func testSandbox(id string, state lxf.SandboxState) *lxf.Sandbox {
+	sb := &lxf.Sandbox{State: state}
+	sb.ID = id
+	sb.NetworkConfig.Mode = lxf.NetworkCNI
+	sb.Config = map[string]string{"raw.lxc": shareNetPrefix + "/proc/self/ns/net"}
+
+	return sb
+}
+
+func TestRuntimeServer_PodSandboxStatus_Readiness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		state  lxf.SandboxState
+		netErr error
+		exp    rtApi.PodSandboxState
+		expIP  string
+	}{
+		{"ready", lxf.SandboxReady, nil, rtApi.PodSandboxState_SANDBOX_READY, "10.22.0.64"},
+		{"network lost", lxf.SandboxReady, network.ErrNetnsLost, rtApi.PodSandboxState_SANDBOX_NOTREADY, ""},
+		{"stopped", lxf.SandboxNotReady, nil, rtApi.PodSandboxState_SANDBOX_NOTREADY, "10.22.0.64"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &crifakes.FakeClient{}
+			fake.GetSandboxReturns(testSandbox("foo", tt.state), nil)
+
+			s := RuntimeServer{lxf: fake, network: &testNetworkPlugin{err: tt.netErr}, netStatus: newNetworkStatuses()}
+
+			resp, err := s.PodSandboxStatus(ctx, &rtApi.PodSandboxStatusRequest{PodSandboxId: "foo"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.exp, resp.GetStatus().GetState())
+			assert.Equal(t, tt.expIP, resp.GetStatus().GetNetwork().GetIp())
+		})
+	}
+}
+
+func TestRuntimeServer_ListPodSandbox_NetworkLost(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	fake.ListSandboxesReturns([]*lxf.Sandbox{testSandbox("foo", lxf.SandboxReady)}, nil)
+
+	s := RuntimeServer{lxf: fake, network: &testNetworkPlugin{err: network.ErrNetnsLost}, netStatus: newNetworkStatuses()}
+
+	resp, err := s.ListPodSandbox(ctx, &rtApi.ListPodSandboxRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetItems(), 1)
+	assert.Equal(t, rtApi.PodSandboxState_SANDBOX_NOTREADY, resp.GetItems()[0].GetState())
+
+	resp, err = s.ListPodSandbox(ctx, &rtApi.ListPodSandboxRequest{Filter: &rtApi.PodSandboxFilter{
+		State: &rtApi.PodSandboxStateValue{State: rtApi.PodSandboxState_SANDBOX_READY},
+	}})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetItems())
+}
+
+func TestRuntimeServer_ListPodSandbox_NetNamespaceLost(t *testing.T) {
+	t.Parallel()
+
+	// the list reports the same state as the status of the pod
+	sb := testSandbox("foo", lxf.SandboxReady)
+	sb.Config["raw.lxc"] = shareNetPrefix + filepath.Join(t.TempDir(), "foo")
+
+	fake := &crifakes.FakeClient{}
+	fake.ListSandboxesReturns([]*lxf.Sandbox{sb}, nil)
+
+	s := RuntimeServer{lxf: fake, network: &testNetworkPlugin{}, netStatus: newNetworkStatuses()}
+
+	resp, err := s.ListPodSandbox(ctx, &rtApi.ListPodSandboxRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetItems(), 1)
+	assert.Equal(t, rtApi.PodSandboxState_SANDBOX_NOTREADY, resp.GetItems()[0].GetState())
+}
+
+func TestRuntimeServer_ListPodSandbox_CachedNetworkStatus(t *testing.T) {
+	t.Parallel()
+
+	fake := &crifakes.FakeClient{}
+	fake.ListSandboxesReturns([]*lxf.Sandbox{testSandbox("foo", lxf.SandboxReady)}, nil)
+
+	plugin := &testNetworkPlugin{}
+	s := RuntimeServer{lxf: fake, network: plugin, netStatus: newNetworkStatuses()}
+
+	for i := 0; i < 3; i++ {
+		resp, err := s.ListPodSandbox(ctx, &rtApi.ListPodSandboxRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, rtApi.PodSandboxState_SANDBOX_READY, resp.GetItems()[0].GetState())
+	}
+
+	assert.Equal(t, 1, plugin.statuses)
+
+	// a changed network is asked for again
+	s.netStatus.forget("foo")
+
+	_, err := s.ListPodSandbox(ctx, &rtApi.ListPodSandboxRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, plugin.statuses)
+}
+
+func TestRuntimeServer_PodSandboxStatus_NetNamespaceLost(t *testing.T) {
+	t.Parallel()
+
+	// the bind mount is gone after a reboot, the network plugin can't tell if it didn't record the namespace
+	sb := testSandbox("foo", lxf.SandboxReady)
+	sb.Config["raw.lxc"] = "lxc.apparmor.profile = unconfined\n" + shareNetPrefix + filepath.Join(t.TempDir(), "foo")
+
+	fake := &crifakes.FakeClient{}
+	fake.GetSandboxReturns(sb, nil)
+
+	s := RuntimeServer{lxf: fake, network: &testNetworkPlugin{}, netStatus: newNetworkStatuses()}
+
+	resp, err := s.PodSandboxStatus(ctx, &rtApi.PodSandboxStatusRequest{PodSandboxId: "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, rtApi.PodSandboxState_SANDBOX_NOTREADY, resp.GetStatus().GetState())
+}
+
+func TestRuntimeServer_PodSandboxStatus_WithoutNetNamespace(t *testing.T) {
+	t.Parallel()
+
+	// the network of a pod without namespace is the one of its containers
+	s, _ := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	resp, err := s.PodSandboxStatus(ctx, &rtApi.PodSandboxStatusRequest{PodSandboxId: pod.GetPodSandboxId()})
+	require.NoError(t, err)
+	assert.Equal(t, rtApi.PodSandboxState_SANDBOX_NOTREADY, resp.GetStatus().GetState())
+
+	cont, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	_, err = s.StartContainer(ctx, &rtApi.StartContainerRequest{ContainerId: cont.GetContainerId()})
+	require.NoError(t, err)
+
+	resp, err = s.PodSandboxStatus(ctx, &rtApi.PodSandboxStatusRequest{PodSandboxId: pod.GetPodSandboxId()})
+	require.NoError(t, err)
+	assert.Equal(t, rtApi.PodSandboxState_SANDBOX_READY, resp.GetStatus().GetState())
+}
+
+// recordingNetworkPlugin records the calls to the pod and container networks, the calls listed in fail return an
+// error. Started pod networks have the namespace netns, if set.
+type recordingNetworkPlugin struct {
+	network.Plugin
+	calls []string
+	fail  map[string]bool
+	netns string
+}
+
+func (p *recordingNetworkPlugin) PodNetwork(_ string, _ map[string]string) (network.PodNetwork, error) {
+	return &recordingPodNetwork{plugin: p}, nil
+}
+
+func (p *recordingNetworkPlugin) call(name string) error {
+	p.calls = append(p.calls, name)
+
+	if p.fail[name] {
+		return errors.New("some network error")
+	}
+
+	return nil
+}
+
+type recordingPodNetwork struct {
+	network.PodNetwork
+	plugin *recordingNetworkPlugin
+}
+
+func (n *recordingPodNetwork) ContainerNetwork(_ string, _ map[string]string) (network.ContainerNetwork, error) {
+	return &recordingContainerNetwork{plugin: n.plugin}, nil
+}
+
+// Status isn't recorded, as it's asked any number of times
+func (n *recordingPodNetwork) Status(_ context.Context, _ *network.PropertiesRunning) (*network.Status, error) {
+	if n.plugin.fail["pod status"] {
+		return nil, network.ErrNetnsLost
+	}
+
+	return &network.Status{}, nil
+}
+
+func (n *recordingPodNetwork) WhenCreated(_ context.Context, _ *network.Properties) (*network.Result, error) {
+	return nil, n.plugin.call("pod created")
+}
+
+func (n *recordingPodNetwork) WhenStarted(_ context.Context, _ *network.PropertiesRunning) (*network.Result, error) {
+	err := n.plugin.call("pod started")
+	if err != nil || n.plugin.netns == "" {
+		return nil, err
+	}
+
+	return &network.Result{NetNamespace: n.plugin.netns}, nil
+}
+
+func (n *recordingPodNetwork) WhenStopped(_ context.Context, _ *network.Properties) error {
+	return n.plugin.call("pod stopped")
+}
+
+func (n *recordingPodNetwork) WhenDeleted(_ context.Context, _ *network.Properties) error {
+	return n.plugin.call("pod deleted")
+}
+
+type recordingContainerNetwork struct {
+	network.ContainerNetwork
+	plugin *recordingNetworkPlugin
+}
+
+func (n *recordingContainerNetwork) WhenCreated(_ context.Context, _ *network.Properties) (*network.Result, error) {
+	return nil, n.plugin.call("container created")
+}
+
+func (n *recordingContainerNetwork) WhenDeleted(_ context.Context, _ *network.Properties) error {
+	return n.plugin.call("container deleted")
+}
+
+func (n *recordingContainerNetwork) WhenStarted(_ context.Context, _ *network.PropertiesRunning) (*network.Result, error) {
+	return nil, n.plugin.call("container started")
+}
+
+func TestRuntimeServer_setupPodNetwork_Rollback(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fail []string
+		exp  []string
+	}{
+		{"created fails", []string{"pod created"}, []string{"pod created", "undo pod"}},
+		{"started fails", []string{"pod started"}, []string{"pod created", "pod started", "pod deleted", "undo pod"}},
+		{"undo fails", []string{"pod started", "pod deleted"}, []string{"pod created", "pod started", "pod deleted", "undo pod"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := &recordingNetworkPlugin{fail: map[string]bool{}}
+			for _, f := range tt.fail {
+				plugin.fail[f] = true
+			}
+
+			s := RuntimeServer{network: plugin, netStatus: newNetworkStatuses()}
+
+			tx := newTransaction(logrus.New())
+			tx.done("create pod", func(_ context.Context) error {
+				plugin.calls = append(plugin.calls, "undo pod")
+
+				return nil
+			})
+
+			err := s.setupPodNetwork(ctx, tx, testSandbox("foo", lxf.SandboxReady))
+			assert.Error(t, err)
+
+			tx.rollback()
+			assert.Equal(t, tt.exp, plugin.calls)
+		})
+	}
+}
+
+func TestRuntimeServer_setupPodNetwork_Commit(t *testing.T) {
+	t.Parallel()
+
+	plugin := &recordingNetworkPlugin{}
+	s := RuntimeServer{network: plugin, netStatus: newNetworkStatuses()}
+	tx := newTransaction(logrus.New())
+
+	err := s.setupPodNetwork(ctx, tx, testSandbox("foo", lxf.SandboxReady))
+	assert.NoError(t, err)
+
+	tx.commit()
+	tx.rollback()
+	assert.Equal(t, []string{"pod created", "pod started"}, plugin.calls)
+}
+
+func TestRuntimeServer_createContainerNetwork_Rollback(t *testing.T) {
+	t.Parallel()
+
+	plugin := &recordingNetworkPlugin{fail: map[string]bool{"container created": true}}
+	s := RuntimeServer{network: plugin, netStatus: newNetworkStatuses()}
+
+	tx := newTransaction(logrus.New())
+	tx.done("create container", func(_ context.Context) error {
+		plugin.calls = append(plugin.calls, "undo container")
+
+		return nil
+	})
+
+	c := &lxf.Container{}
+	c.ID = "bar"
+
+	err := s.createContainerNetwork(ctx, tx, testSandbox("foo", lxf.SandboxReady), c)
+	assert.Error(t, err)
+
+	tx.rollback()
+	assert.Equal(t, []string{"container created", "undo container"}, plugin.calls)
+}
@@ -0,0 +1,157 @@
+package cri
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func testRunPodSandboxRequest(uid string) *rtApi.RunPodSandboxRequest {
+	return &rtApi.RunPodSandboxRequest{
+		Config: &rtApi.PodSandboxConfig{
+			Metadata: &rtApi.PodSandboxMetadata{Name: "pod", Namespace: "default", Uid: uid},
+		},
+	}
+}
+
+func testCreateContainerRequest(podID string, config *rtApi.ContainerConfig) *rtApi.CreateContainerRequest {
+	config.Metadata = &rtApi.ContainerMetadata{Name: "debugger"}
+	config.Image = &rtApi.ImageSpec{Image: "ubuntu"}
+
+	return &rtApi.CreateContainerRequest{PodSandboxId: podID, Config: config}
+}
+
+func TestRuntimeServer_CreateContainer_InteractiveWithoutCommand(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	resp, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{
+		Stdin: true,
+		Tty:   true,
+	}))
+	require.NoError(t, err)
+
+	c, err := s.lxf.GetContainer(ctx, resp.GetContainerId())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/sh"}, c.Command)
+	assert.True(t, c.Job)
+	assert.True(t, c.Interactive)
+
+	fakeOp := &lxdfakes.FakeOperation{}
+	fakeOp.GetReturns(api.Operation{Metadata: map[string]interface{}{"return": float64(0)}})
+
+	fake.ExecInstanceCalls(func(_ string, _ api.InstanceExecPost, args *lxd.InstanceExecArgs) (lxd.Operation, error) {
+		go func() { args.DataDone <- true }()
+
+		return fakeOp, nil
+	})
+
+	ss := streamService{runtimeServer: &s}
+	err = ss.Attach(resp.GetContainerId(), bytes.NewReader(nil), nopWriteCloser{}, nopWriteCloser{}, true, nil)
+	assert.NoError(t, err)
+
+	require.Equal(t, 1, fake.ExecInstanceCallCount())
+	_, exec, _ := fake.ExecInstanceArgsForCall(0)
+	assert.Equal(t, []string{"/bin/sh"}, exec.Command)
+	assert.True(t, exec.Interactive)
+}
+
+func TestRuntimeServer_CreateContainer_WithoutCommand(t *testing.T) {
+	t.Parallel()
+
+	s, _ := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	resp, err := s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	require.NoError(t, err)
+
+	c, err := s.lxf.GetContainer(ctx, resp.GetContainerId())
+	require.NoError(t, err)
+	assert.Empty(t, c.Command)
+	assert.False(t, c.Job)
+}
+
+func TestRuntimeServer_RunPodSandbox_RollbackApply(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	// the profile is created, but can't be loaded afterwards
+	fake.GetProfileReturns(nil, "", errors.New("some lxd error"))
+
+	_, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.CreateProfileCallCount())
+	assert.Equal(t, 1, fake.DeleteProfileCallCount())
+	assert.Empty(t, fake.profiles)
+}
+
+func TestRuntimeServer_RunPodSandbox_RollbackNetwork(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	plugin := &recordingNetworkPlugin{fail: map[string]bool{"pod started": true}}
+	s.network = plugin
+
+	_, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"pod created", "pod started", "pod deleted"}, plugin.calls)
+	assert.Empty(t, fake.profiles)
+}
+
+func TestRuntimeServer_CreateContainer_RollbackApply(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	// the instance is created, but can't be loaded afterwards
+	fake.GetInstanceReturns(nil, "", errors.New("some lxd error"))
+
+	_, err = s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.CreateInstanceCallCount())
+	assert.Equal(t, 1, fake.DeleteInstanceCallCount())
+	assert.Empty(t, fake.instances)
+	assert.Contains(t, fake.profiles, pod.GetPodSandboxId())
+}
+
+func TestRuntimeServer_CreateContainer_RollbackNetwork(t *testing.T) {
+	t.Parallel()
+
+	s, fake := testLXDRuntimeServer(t)
+	plugin := &recordingNetworkPlugin{fail: map[string]bool{"container created": true}}
+	s.network = plugin
+
+	pod, err := s.RunPodSandbox(ctx, testRunPodSandboxRequest("uid"))
+	require.NoError(t, err)
+
+	_, err = s.CreateContainer(ctx, testCreateContainerRequest(pod.GetPodSandboxId(), &rtApi.ContainerConfig{}))
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.CreateInstanceCallCount())
+	assert.Empty(t, fake.instances)
+	assert.Contains(t, fake.profiles, pod.GetPodSandboxId())
+}
+
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(p []byte) (int, error) { return len(p), nil }
+func (nopWriteCloser) Close() error                { return nil }
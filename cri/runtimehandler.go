@@ -0,0 +1,201 @@
+package cri
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/automaticserver/lxe/lxf"
+)
+
+const (
+	// annotationPrefix of the annotations which configure LXE
+	annotationPrefix   = "lxe.automaticserver.io/"
+	annotationWildcard = "*"
+)
+
+var (
+	ErrRuntimeHandler     = errors.New("invalid runtime handler")
+	ErrUnknownHandler     = errors.New("unknown runtime handler")
+	ErrAnnotationRejected = errors.New("annotation not allowed")
+)
+
+// RuntimeHandlers are selected by the runtimeClassName of a pod, the handler of a RuntimeClass is the key. Pods
+// without a runtime class use the default handler, which has no additional setup.
+type RuntimeHandlers map[string]RuntimeHandler
+
+// RuntimeHandler extends the setup of the containers of a pod
+type RuntimeHandler struct {
+	// Profiles are added to the containers in addition to LXDProfiles
+	Profiles []string
+	// Type of the instances the containers are run as, "container" (default) or "virtual-machine"
+	Type string
+	// Config is set on the containers, keys managed by LXE can't be set
+	Config map[string]string
+	// Annotations prefixed with `lxe.automaticserver.io/` pods and containers are allowed to use. A trailing "*"
+	// matches any suffix.
+	Annotations []string
+}
+
+// Validate checks the instance types and that config keys of LXE are not set
+func (h RuntimeHandlers) Validate() error {
+	for name, handler := range h {
+		switch lxf.InstanceType(handler.Type) {
+		case "", lxf.InstanceTypeContainer, lxf.InstanceTypeVirtualMachine:
+		default:
+			return fmt.Errorf("%w: %s: unknown type: %s", ErrRuntimeHandler, name, handler.Type)
+		}
+
+		for k := range handler.Config {
+			if lxf.IsReservedContainerConfig(k) {
+				return fmt.Errorf("%w: %s: config key is reserved: %s", ErrRuntimeHandler, name, k)
+			}
+		}
+	}
+
+	return nil
+}
+
+// get returns the handler with the name, the empty name is the default handler
+func (h RuntimeHandlers) get(name string) (RuntimeHandler, error) {
+	if name == "" {
+		return RuntimeHandler{}, nil
+	}
+
+	handler, has := h[name]
+	if !has {
+		return RuntimeHandler{}, fmt.Errorf("%w: %s", ErrUnknownHandler, name)
+	}
+
+	return handler, nil
+}
+
+type verboseStatusInfo struct {
+	RuntimeHandlers []runtimeHandlerFeatures `json:"runtimeHandlers"`
+}
+
+type runtimeHandlerFeatures struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// features lists all handlers including the default handler, ordered by name
+func (h RuntimeHandlers) features() []runtimeHandlerFeatures {
+	names := []string{""}
+	for name := range h {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	features := make([]runtimeHandlerFeatures, 0, len(names))
+
+	for _, name := range names {
+		typ := h[name].Type
+		if typ == "" {
+			typ = string(lxf.InstanceTypeContainer)
+		}
+
+		features = append(features, runtimeHandlerFeatures{Name: name, Type: typ})
+	}
+
+	return features
+}
+
+// allowsAnnotation checks the annotation key without the prefix against the allowed ones
+func (h RuntimeHandler) allowsAnnotation(key string) bool {
+	patterns := make([]string, 0, len(h.Annotations))
+	for _, a := range h.Annotations {
+		patterns = append(patterns, strings.TrimPrefix(a, annotationPrefix))
+	}
+
+	return matchesPattern(patterns, key)
+}
+
+// matchesPattern checks if the value equals one of the patterns, a trailing "*" matches any suffix
+func matchesPattern(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if p == value || (strings.HasSuffix(p, annotationWildcard) && strings.HasPrefix(value, strings.TrimSuffix(p, annotationWildcard))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// profiles returns the additional profiles of containers, the ones of the handler after the global ones
+func (h RuntimeHandler) profiles(global []string) []string {
+	profiles := make([]string, 0, len(global)+len(h.Profiles))
+
+	return append(append(profiles, global...), h.Profiles...)
+}
+
+// allProfiles returns the global profiles followed by the ones of all handlers, each profile only once
+func (h RuntimeHandlers) allProfiles(global []string) []string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	profiles := []string{}
+	for _, profile := range global {
+		profiles = appendUnique(profiles, profile)
+	}
+
+	for _, name := range names {
+		for _, profile := range h[name].Profiles {
+			profiles = appendUnique(profiles, profile)
+		}
+	}
+
+	return profiles
+}
+
+// apply the type and config of the handler to the container to be created
+func (h RuntimeHandler) apply(c *lxf.Container) {
+	if h.Type != "" {
+		c.Type = lxf.InstanceType(h.Type)
+	}
+
+	for k, v := range h.Config {
+		c.Config[k] = v
+	}
+}
+
+// podHandlers remembers the runtime handler of the pods by their uid. An image is pulled for the instance type of the
+// pod it's pulled for, but the pull request only has the metadata of the pod.
+type podHandlers struct {
+	mu       sync.RWMutex
+	handlers map[string]string
+}
+
+func newPodHandlers() *podHandlers {
+	return &podHandlers{handlers: map[string]string{}}
+}
+
+func (p *podHandlers) get(uid string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	handler, has := p.handlers[uid]
+
+	return handler, has
+}
+
+func (p *podHandlers) set(uid, handler string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.handlers[uid] = handler
+}
+
+func (p *podHandlers) delete(uid string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.handlers, uid)
+}
@@ -0,0 +1,110 @@
+package cri
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/automaticserver/lxe/lxf"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	rtApi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestRuntimeHandlers_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		handlers RuntimeHandlers
+		wantErr  bool
+	}{
+		{"empty", nil, false},
+		{"vm", RuntimeHandlers{"lxe-vm": {Type: "virtual-machine"}}, false},
+		{"config", RuntimeHandlers{"lxe-nested": {Config: map[string]string{"security.nesting": "true"}}}, false},
+		{"unknown type", RuntimeHandlers{"lxe-oci": {Type: "oci"}}, true},
+		{"reserved key", RuntimeHandlers{"lxe-priv": {Config: map[string]string{"security.privileged": "true"}}}, true},
+		{"reserved prefix", RuntimeHandlers{"lxe-env": {Config: map[string]string{"environment.FOO": "bar"}}}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.handlers.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrRuntimeHandler)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRuntimeHandlers_get(t *testing.T) {
+	t.Parallel()
+
+	handlers := RuntimeHandlers{"lxe-vm": {Type: "virtual-machine"}}
+
+	h, err := handlers.get("")
+	assert.NoError(t, err)
+	assert.Equal(t, RuntimeHandler{}, h)
+
+	h, err = handlers.get("lxe-vm")
+	assert.NoError(t, err)
+	assert.Equal(t, "virtual-machine", h.Type)
+
+	_, err = handlers.get("lxe-oci")
+	assert.ErrorIs(t, err, ErrUnknownHandler)
+}
+
+func TestRuntimeHandlers_allProfiles(t *testing.T) {
+	t.Parallel()
+
+	handlers := RuntimeHandlers{
+		"lxe-vm":     {Profiles: []string{"vm", "shared"}},
+		"lxe-nested": {Profiles: []string{"nesting", "shared"}},
+	}
+
+	assert.Equal(t, []string{"default", "shared", "nesting", "vm"}, handlers.allProfiles([]string{"default", "shared"}))
+
+	conn := lxdConnection(&Config{LXDProfiles: []string{"default"}, RuntimeHandlers: handlers})
+	assert.Equal(t, []string{"default", "nesting", "shared", "vm"}, conn.Profiles)
+}
+
+func TestRuntimeHandler_apply(t *testing.T) {
+	t.Parallel()
+
+	h := RuntimeHandler{
+		Profiles: []string{"vm"},
+		Type:     "virtual-machine",
+		Config:   map[string]string{"limits.cpu": "2"},
+	}
+
+	global := []string{"default"}
+	assert.Equal(t, []string{"default", "vm"}, h.profiles(global))
+	assert.Equal(t, []string{"default"}, global)
+
+	c := &lxf.Container{}
+	c.Config = map[string]string{}
+	h.apply(c)
+
+	assert.Equal(t, lxf.InstanceTypeVirtualMachine, c.Type)
+	assert.Equal(t, "2", c.Config["limits.cpu"])
+}
+
+func TestRuntimeServer_Status_RuntimeHandlers(t *testing.T) {
+	t.Parallel()
+
+	s := RuntimeServer{criConfig: &Config{RuntimeHandlers: RuntimeHandlers{"lxe-vm": {Type: "virtual-machine"}}}}
+
+	resp, err := s.Status(context.Background(), &rtApi.StatusRequest{Verbose: true})
+	assert.NoError(t, err)
+
+	info := verboseStatusInfo{}
+	assert.NoError(t, json.Unmarshal([]byte(resp.Info["info"]), &info))
+	assert.Equal(t, []runtimeHandlerFeatures{
+		{Name: "", Type: "container"},
+		{Name: "lxe-vm", Type: "virtual-machine"},
+	}, info.RuntimeHandlers)
+}
@@ -33,6 +33,7 @@ var (
 // Server implements the kubernetes CRI interface specification
 type Server struct {
 	server    *grpc.Server
+	runtime   *RuntimeServer
 	stream    *streamService
 	sock      net.Listener
 	criConfig *Config
@@ -54,12 +55,17 @@ func NewServer(criConfig *Config) *Server { // nolint: cyclop
 
 	log.WithField("path", criConfig.LXDRemoteConfig).Debug("Using lxd remote config")
 
-	client, err := lxf.NewClient(criConfig.LXDSocket, criConfig.LXDRemoteConfig)
+	client, err := lxf.NewClient(lxdConnection(criConfig), criConfig.LXDRemoteConfig)
 	if err != nil {
 		log.WithError(err).Fatal("Unable to initialize lxe facade")
 	}
 
-	log.WithField("lxdsocket", criConfig.LXDSocket).Info("Connected to LXD")
+	log.WithFields(logrus.Fields{"lxdsocket": criConfig.LXDSocket, "lxdremote": criConfig.LXDRemote}).Info("Connected to LXD")
+
+	err = client.SetImageRewriteRules(criConfig.ImageRewriteRules)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid image rewrite rules")
+	}
 
 	if criConfig.CRITest {
 		log.Warn("CRITest mode enabled")
@@ -70,7 +76,7 @@ func NewServer(criConfig *Config) *Server { // nolint: cyclop
 	// Ensure profile and container schema migration
 	migration := lxf.NewMigrationWorkspace(client)
 
-	err = migration.Ensure()
+	err = migration.Ensure(context.Background())
 	if err != nil {
 		log.WithError(err).Fatal("Migration failed")
 	}
@@ -140,6 +146,14 @@ func NewServer(criConfig *Config) *Server { // nolint: cyclop
 		log.WithError(err).Fatal("Unable to start image server")
 	}
 
+	if criConfig.ImageGCInterval > 0 {
+		go runImageReconciler(client, criConfig)
+	}
+
+	if criConfig.GCInterval > 0 {
+		go runtimeServer.runGarbageCollector()
+	}
+
 	rtApi.RegisterRuntimeServiceServer(grpcServer, runtimeServer)
 	rtApi.RegisterImageServiceServer(grpcServer, imageServer)
 
@@ -148,6 +162,7 @@ func NewServer(criConfig *Config) *Server { // nolint: cyclop
 
 	return &Server{
 		server:    grpcServer,
+		runtime:   runtimeServer,
 		stream:    runtimeServer.stream,
 		criConfig: criConfig,
 	}
@@ -186,6 +201,9 @@ func (c *Server) Serve() error {
 		}
 	}()
 
+	// lifecycle events might have been missed while LXE wasn't running, kubelet is served in the meantime
+	go c.runtime.runReconcile()
+
 	return c.server.Serve(c.sock)
 }
 
@@ -102,7 +102,9 @@ func (ss *streamService) serve() error {
 }
 
 func (ss streamService) Exec(containerID string, cmd []string, stdinR io.Reader, stdout, stderr io.WriteCloser, tty bool, resize <-chan remotecommand.TerminalSize) error {
-	log := log.WithField("container", containerID).WithField("cmd", cmd)
+	// the streaming server doesn't provide a context of the request
+	ctx := context.TODO()
+	log := log.WithContext(ctx).WithField("container", containerID).WithField("cmd", cmd)
 
 	var stdin io.ReadCloser
 	if stdinR == nil {
@@ -113,7 +115,7 @@ func (ss streamService) Exec(containerID string, cmd []string, stdinR io.Reader,
 
 	interactive := (stdinR != nil)
 
-	code, err := ss.runtimeServer.lxf.Exec(containerID, cmd, stdin, stdout, stderr, interactive, tty, 0, resize)
+	code, err := ss.runtimeServer.lxf.Exec(ctx, containerID, cmd, stdin, stdout, stderr, interactive, tty, 0, resize)
 
 	log.Debugf("received exit code %v", code)
 	log = log.WithField("exit", code)
@@ -128,15 +130,52 @@ func (ss streamService) Exec(containerID string, cmd []string, stdinR io.Reader,
 	return nil
 }
 
+// Attach runs the command of an interactive job container with the streams attached
+func (ss streamService) Attach(containerID string, stdinR io.Reader, stdout, stderr io.WriteCloser, tty bool, resize <-chan remotecommand.TerminalSize) error {
+	// the streaming server doesn't provide a context of the request
+	ctx := context.TODO()
+	log := log.WithContext(ctx).WithField("container", containerID)
+
+	c, err := ss.runtimeServer.lxf.GetContainer(ctx, containerID)
+	if err != nil {
+		return AnnErr(log, codes.Unknown, err, "unable to get container")
+	}
+
+	var stdin io.ReadCloser
+	if stdinR == nil {
+		stdin = io.NopCloser(bytes.NewReader(nil))
+	} else {
+		stdin = io.NopCloser(stdinR)
+	}
+
+	// the tty was decided when the container was created
+	c.TTY = c.TTY && tty
+
+	code, err := c.AttachJob(ctx, stdin, stdout, stderr, resize)
+
+	log = log.WithField("exit", code)
+	log.Debug("attached job finished")
+
+	if err != nil || code != 0 {
+		return &utilExec.CodeExitError{
+			Err:  AnnErr(log, codes.Unknown, err, "error attaching to job"),
+			Code: int(code),
+		}
+	}
+
+	return nil
+}
+
 func (ss streamService) PortForward(podSandboxID string, port int32, stream io.ReadWriteCloser) error {
-	log := log.WithField("podsandbox", podSandboxID).WithField("port", port)
+	ctx := context.TODO()
+	log := log.WithContext(ctx).WithField("podsandbox", podSandboxID).WithField("port", port)
 
-	sb, err := ss.runtimeServer.lxf.GetSandbox(podSandboxID)
+	sb, err := ss.runtimeServer.lxf.GetSandbox(ctx, podSandboxID)
 	if err != nil {
 		return AnnErr(log, codes.Unknown, err, "unable to find pod")
 	}
 
-	podIP := ss.runtimeServer.getInetAddress(context.TODO(), sb)
+	podIP := ss.runtimeServer.getInetAddress(ctx, sb)
 
 	_, err = exec.LookPath("socat")
 	if err != nil {
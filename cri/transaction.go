@@ -0,0 +1,65 @@
+package cri
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RollbackTimeout is the time given to undo the steps of a failed request. The steps are not undone with the context
+// of the request, as it might have failed because that context ended.
+var RollbackTimeout = 30 * time.Second
+
+// transaction records the completed steps of creating a pod or container, so they can be undone if a later step fails
+type transaction struct {
+	log       logrus.FieldLogger
+	steps     []transactionStep
+	committed bool
+}
+
+type transactionStep struct {
+	name string
+	undo func(ctx context.Context) error
+}
+
+// newTransaction returns a transaction, whose rollback should be deferred right away
+func newTransaction(log logrus.FieldLogger) *transaction {
+	return &transaction{log: log}
+}
+
+// done records a completed step and how to undo it
+func (t *transaction) done(name string, undo func(ctx context.Context) error) {
+	t.steps = append(t.steps, transactionStep{name: name, undo: undo})
+}
+
+// commit marks the transaction as successful, so the steps are kept
+func (t *transaction) commit() {
+	t.committed = true
+}
+
+// rollback undoes the completed steps in reverse order, unless the transaction was committed. A failing step is only
+// logged, the remaining steps are still undone.
+func (t *transaction) rollback() {
+	if t.committed || len(t.steps) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), RollbackTimeout)
+	defer cancel()
+
+	for i := len(t.steps) - 1; i >= 0; i-- {
+		step := t.steps[i]
+
+		err := step.undo(ctx)
+		if err != nil {
+			t.log.WithError(err).WithField("step", step.name).Error("unable to roll back")
+
+			continue
+		}
+
+		t.log.WithField("step", step.name).Info("rolled back")
+	}
+
+	t.steps = nil
+}
@@ -0,0 +1,64 @@
+package cri
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTransaction(calls *[]string, steps ...string) *transaction {
+	tx := newTransaction(logrus.New())
+
+	for _, step := range steps {
+		step := step
+		tx.done(step, func(_ context.Context) error {
+			*calls = append(*calls, step)
+
+			if step == "fail" {
+				return errors.New("some undo error")
+			}
+
+			return nil
+		})
+	}
+
+	return tx
+}
+
+func TestTransaction_Rollback_ReverseOrder(t *testing.T) {
+	t.Parallel()
+
+	calls := []string{}
+	tx := testTransaction(&calls, "first", "second", "third")
+
+	tx.rollback()
+	assert.Equal(t, []string{"third", "second", "first"}, calls)
+
+	// the steps are only undone once
+	tx.rollback()
+	assert.Len(t, calls, 3)
+}
+
+func TestTransaction_Rollback_Committed(t *testing.T) {
+	t.Parallel()
+
+	calls := []string{}
+	tx := testTransaction(&calls, "first", "second")
+
+	tx.commit()
+	tx.rollback()
+	assert.Empty(t, calls)
+}
+
+func TestTransaction_Rollback_ContinuesOnError(t *testing.T) {
+	t.Parallel()
+
+	calls := []string{}
+	tx := testTransaction(&calls, "first", "fail", "third")
+
+	tx.rollback()
+	assert.Equal(t, []string{"third", "fail", "first"}, calls)
+}
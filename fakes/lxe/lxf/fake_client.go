@@ -2,6 +2,7 @@
 package lxf
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -11,18 +12,19 @@ import (
 )
 
 type FakeClient struct {
-	ExecStub        func(string, []string, io.ReadCloser, io.WriteCloser, io.WriteCloser, bool, bool, int64, <-chan remotecommand.TerminalSize) (int32, error)
+	ExecStub        func(context.Context, string, []string, io.ReadCloser, io.WriteCloser, io.WriteCloser, bool, bool, int64, <-chan remotecommand.TerminalSize) (int32, error)
 	execMutex       sync.RWMutex
 	execArgsForCall []struct {
-		arg1 string
-		arg2 []string
-		arg3 io.ReadCloser
-		arg4 io.WriteCloser
-		arg5 io.WriteCloser
-		arg6 bool
-		arg7 bool
-		arg8 int64
-		arg9 <-chan remotecommand.TerminalSize
+		arg1  context.Context
+		arg2  string
+		arg3  []string
+		arg4  io.ReadCloser
+		arg5  io.WriteCloser
+		arg6  io.WriteCloser
+		arg7  bool
+		arg8  bool
+		arg9  int64
+		arg10 <-chan remotecommand.TerminalSize
 	}
 	execReturns struct {
 		result1 int32
@@ -32,10 +34,11 @@ type FakeClient struct {
 		result1 int32
 		result2 error
 	}
-	GetContainerStub        func(string) (*lxf.Container, error)
+	GetContainerStub        func(context.Context, string) (*lxf.Container, error)
 	getContainerMutex       sync.RWMutex
 	getContainerArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getContainerReturns struct {
 		result1 *lxf.Container
@@ -45,9 +48,10 @@ type FakeClient struct {
 		result1 *lxf.Container
 		result2 error
 	}
-	GetFSPoolUsageStub        func() ([]lxf.FSPoolUsage, error)
+	GetFSPoolUsageStub        func(context.Context) ([]lxf.FSPoolUsage, error)
 	getFSPoolUsageMutex       sync.RWMutex
 	getFSPoolUsageArgsForCall []struct {
+		arg1 context.Context
 	}
 	getFSPoolUsageReturns struct {
 		result1 []lxf.FSPoolUsage
@@ -57,10 +61,11 @@ type FakeClient struct {
 		result1 []lxf.FSPoolUsage
 		result2 error
 	}
-	GetImageStub        func(string) (*lxf.Image, error)
+	GetImageStub        func(context.Context, string) (*lxf.Image, error)
 	getImageMutex       sync.RWMutex
 	getImageArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getImageReturns struct {
 		result1 *lxf.Image
@@ -70,9 +75,25 @@ type FakeClient struct {
 		result1 *lxf.Image
 		result2 error
 	}
-	GetRuntimeInfoStub        func() (*lxf.RuntimeInfo, error)
+	GetImageVariantStub        func(context.Context, string, lxf.InstanceType) (*lxf.Image, error)
+	getImageVariantMutex       sync.RWMutex
+	getImageVariantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 lxf.InstanceType
+	}
+	getImageVariantReturns struct {
+		result1 *lxf.Image
+		result2 error
+	}
+	getImageVariantReturnsOnCall map[int]struct {
+		result1 *lxf.Image
+		result2 error
+	}
+	GetRuntimeInfoStub        func(context.Context) (*lxf.RuntimeInfo, error)
 	getRuntimeInfoMutex       sync.RWMutex
 	getRuntimeInfoArgsForCall []struct {
+		arg1 context.Context
 	}
 	getRuntimeInfoReturns struct {
 		result1 *lxf.RuntimeInfo
@@ -82,10 +103,11 @@ type FakeClient struct {
 		result1 *lxf.RuntimeInfo
 		result2 error
 	}
-	GetSandboxStub        func(string) (*lxf.Sandbox, error)
+	GetSandboxStub        func(context.Context, string) (*lxf.Sandbox, error)
 	getSandboxMutex       sync.RWMutex
 	getSandboxArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getSandboxReturns struct {
 		result1 *lxf.Sandbox
@@ -95,19 +117,20 @@ type FakeClient struct {
 		result1 *lxf.Sandbox
 		result2 error
 	}
-	GetServerStub        func() lxd.ContainerServer
+	GetServerStub        func() lxd.InstanceServer
 	getServerMutex       sync.RWMutex
 	getServerArgsForCall []struct {
 	}
 	getServerReturns struct {
-		result1 lxd.ContainerServer
+		result1 lxd.InstanceServer
 	}
 	getServerReturnsOnCall map[int]struct {
-		result1 lxd.ContainerServer
+		result1 lxd.InstanceServer
 	}
-	ListContainersStub        func() ([]*lxf.Container, error)
+	ListContainersStub        func(context.Context) ([]*lxf.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
+		arg1 context.Context
 	}
 	listContainersReturns struct {
 		result1 []*lxf.Container
@@ -117,10 +140,11 @@ type FakeClient struct {
 		result1 []*lxf.Container
 		result2 error
 	}
-	ListImagesStub        func(string) ([]*lxf.Image, error)
+	ListImagesStub        func(context.Context, string) ([]*lxf.Image, error)
 	listImagesMutex       sync.RWMutex
 	listImagesArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	listImagesReturns struct {
 		result1 []*lxf.Image
@@ -130,9 +154,10 @@ type FakeClient struct {
 		result1 []*lxf.Image
 		result2 error
 	}
-	ListSandboxesStub        func() ([]*lxf.Sandbox, error)
+	ListSandboxesStub        func(context.Context) ([]*lxf.Sandbox, error)
 	listSandboxesMutex       sync.RWMutex
 	listSandboxesArgsForCall []struct {
+		arg1 context.Context
 	}
 	listSandboxesReturns struct {
 		result1 []*lxf.Sandbox
@@ -164,10 +189,13 @@ type FakeClient struct {
 	newSandboxReturnsOnCall map[int]struct {
 		result1 *lxf.Sandbox
 	}
-	PullImageStub        func(string) (string, error)
+	PullImageStub        func(context.Context, string, lxf.InstanceType, lxf.ImageSourceCheck) (string, error)
 	pullImageMutex       sync.RWMutex
 	pullImageArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
+		arg3 lxf.InstanceType
+		arg4 lxf.ImageSourceCheck
 	}
 	pullImageReturns struct {
 		result1 string
@@ -177,10 +205,39 @@ type FakeClient struct {
 		result1 string
 		result2 error
 	}
-	RemoveImageStub        func(string) error
+	ReconcileImagesStub        func(context.Context, lxf.ImageReconcileOptions) (*lxf.ImageReconcileReport, error)
+	reconcileImagesMutex       sync.RWMutex
+	reconcileImagesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lxf.ImageReconcileOptions
+	}
+	reconcileImagesReturns struct {
+		result1 *lxf.ImageReconcileReport
+		result2 error
+	}
+	reconcileImagesReturnsOnCall map[int]struct {
+		result1 *lxf.ImageReconcileReport
+		result2 error
+	}
+	ReconcileObjectsStub        func(context.Context, lxf.ObjectReconcileOptions) (*lxf.ObjectReconcileReport, error)
+	reconcileObjectsMutex       sync.RWMutex
+	reconcileObjectsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lxf.ObjectReconcileOptions
+	}
+	reconcileObjectsReturns struct {
+		result1 *lxf.ObjectReconcileReport
+		result2 error
+	}
+	reconcileObjectsReturnsOnCall map[int]struct {
+		result1 *lxf.ObjectReconcileReport
+		result2 error
+	}
+	RemoveImageStub        func(context.Context, string) error
 	removeImageMutex       sync.RWMutex
 	removeImageArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	removeImageReturns struct {
 		result1 error
@@ -197,35 +254,58 @@ type FakeClient struct {
 	setEventHandlerArgsForCall []struct {
 		arg1 lxf.EventHandler
 	}
+	SetImageRewriteRulesStub        func(lxf.ImageRewriteRules) error
+	setImageRewriteRulesMutex       sync.RWMutex
+	setImageRewriteRulesArgsForCall []struct {
+		arg1 lxf.ImageRewriteRules
+	}
+	setImageRewriteRulesReturns struct {
+		result1 error
+	}
+	setImageRewriteRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SubscribeStub        func(lxf.EventSubscriber) func()
+	subscribeMutex       sync.RWMutex
+	subscribeArgsForCall []struct {
+		arg1 lxf.EventSubscriber
+	}
+	subscribeReturns struct {
+		result1 func()
+	}
+	subscribeReturnsOnCall map[int]struct {
+		result1 func()
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeClient) Exec(arg1 string, arg2 []string, arg3 io.ReadCloser, arg4 io.WriteCloser, arg5 io.WriteCloser, arg6 bool, arg7 bool, arg8 int64, arg9 <-chan remotecommand.TerminalSize) (int32, error) {
-	var arg2Copy []string
-	if arg2 != nil {
-		arg2Copy = make([]string, len(arg2))
-		copy(arg2Copy, arg2)
+func (fake *FakeClient) Exec(arg1 context.Context, arg2 string, arg3 []string, arg4 io.ReadCloser, arg5 io.WriteCloser, arg6 io.WriteCloser, arg7 bool, arg8 bool, arg9 int64, arg10 <-chan remotecommand.TerminalSize) (int32, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
 	}
 	fake.execMutex.Lock()
 	ret, specificReturn := fake.execReturnsOnCall[len(fake.execArgsForCall)]
 	fake.execArgsForCall = append(fake.execArgsForCall, struct {
-		arg1 string
-		arg2 []string
-		arg3 io.ReadCloser
-		arg4 io.WriteCloser
-		arg5 io.WriteCloser
-		arg6 bool
-		arg7 bool
-		arg8 int64
-		arg9 <-chan remotecommand.TerminalSize
-	}{arg1, arg2Copy, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
+		arg1  context.Context
+		arg2  string
+		arg3  []string
+		arg4  io.ReadCloser
+		arg5  io.WriteCloser
+		arg6  io.WriteCloser
+		arg7  bool
+		arg8  bool
+		arg9  int64
+		arg10 <-chan remotecommand.TerminalSize
+	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
 	stub := fake.ExecStub
 	fakeReturns := fake.execReturns
-	fake.recordInvocation("Exec", []interface{}{arg1, arg2Copy, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
+	fake.recordInvocation("Exec", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
 	fake.execMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -239,17 +319,17 @@ func (fake *FakeClient) ExecCallCount() int {
 	return len(fake.execArgsForCall)
 }
 
-func (fake *FakeClient) ExecCalls(stub func(string, []string, io.ReadCloser, io.WriteCloser, io.WriteCloser, bool, bool, int64, <-chan remotecommand.TerminalSize) (int32, error)) {
+func (fake *FakeClient) ExecCalls(stub func(context.Context, string, []string, io.ReadCloser, io.WriteCloser, io.WriteCloser, bool, bool, int64, <-chan remotecommand.TerminalSize) (int32, error)) {
 	fake.execMutex.Lock()
 	defer fake.execMutex.Unlock()
 	fake.ExecStub = stub
 }
 
-func (fake *FakeClient) ExecArgsForCall(i int) (string, []string, io.ReadCloser, io.WriteCloser, io.WriteCloser, bool, bool, int64, <-chan remotecommand.TerminalSize) {
+func (fake *FakeClient) ExecArgsForCall(i int) (context.Context, string, []string, io.ReadCloser, io.WriteCloser, io.WriteCloser, bool, bool, int64, <-chan remotecommand.TerminalSize) {
 	fake.execMutex.RLock()
 	defer fake.execMutex.RUnlock()
 	argsForCall := fake.execArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10
 }
 
 func (fake *FakeClient) ExecReturns(result1 int32, result2 error) {
@@ -278,18 +358,19 @@ func (fake *FakeClient) ExecReturnsOnCall(i int, result1 int32, result2 error) {
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetContainer(arg1 string) (*lxf.Container, error) {
+func (fake *FakeClient) GetContainer(arg1 context.Context, arg2 string) (*lxf.Container, error) {
 	fake.getContainerMutex.Lock()
 	ret, specificReturn := fake.getContainerReturnsOnCall[len(fake.getContainerArgsForCall)]
 	fake.getContainerArgsForCall = append(fake.getContainerArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetContainerStub
 	fakeReturns := fake.getContainerReturns
-	fake.recordInvocation("GetContainer", []interface{}{arg1})
+	fake.recordInvocation("GetContainer", []interface{}{arg1, arg2})
 	fake.getContainerMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -303,17 +384,17 @@ func (fake *FakeClient) GetContainerCallCount() int {
 	return len(fake.getContainerArgsForCall)
 }
 
-func (fake *FakeClient) GetContainerCalls(stub func(string) (*lxf.Container, error)) {
+func (fake *FakeClient) GetContainerCalls(stub func(context.Context, string) (*lxf.Container, error)) {
 	fake.getContainerMutex.Lock()
 	defer fake.getContainerMutex.Unlock()
 	fake.GetContainerStub = stub
 }
 
-func (fake *FakeClient) GetContainerArgsForCall(i int) string {
+func (fake *FakeClient) GetContainerArgsForCall(i int) (context.Context, string) {
 	fake.getContainerMutex.RLock()
 	defer fake.getContainerMutex.RUnlock()
 	argsForCall := fake.getContainerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) GetContainerReturns(result1 *lxf.Container, result2 error) {
@@ -342,17 +423,18 @@ func (fake *FakeClient) GetContainerReturnsOnCall(i int, result1 *lxf.Container,
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetFSPoolUsage() ([]lxf.FSPoolUsage, error) {
+func (fake *FakeClient) GetFSPoolUsage(arg1 context.Context) ([]lxf.FSPoolUsage, error) {
 	fake.getFSPoolUsageMutex.Lock()
 	ret, specificReturn := fake.getFSPoolUsageReturnsOnCall[len(fake.getFSPoolUsageArgsForCall)]
 	fake.getFSPoolUsageArgsForCall = append(fake.getFSPoolUsageArgsForCall, struct {
-	}{})
+		arg1 context.Context
+	}{arg1})
 	stub := fake.GetFSPoolUsageStub
 	fakeReturns := fake.getFSPoolUsageReturns
-	fake.recordInvocation("GetFSPoolUsage", []interface{}{})
+	fake.recordInvocation("GetFSPoolUsage", []interface{}{arg1})
 	fake.getFSPoolUsageMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -366,12 +448,19 @@ func (fake *FakeClient) GetFSPoolUsageCallCount() int {
 	return len(fake.getFSPoolUsageArgsForCall)
 }
 
-func (fake *FakeClient) GetFSPoolUsageCalls(stub func() ([]lxf.FSPoolUsage, error)) {
+func (fake *FakeClient) GetFSPoolUsageCalls(stub func(context.Context) ([]lxf.FSPoolUsage, error)) {
 	fake.getFSPoolUsageMutex.Lock()
 	defer fake.getFSPoolUsageMutex.Unlock()
 	fake.GetFSPoolUsageStub = stub
 }
 
+func (fake *FakeClient) GetFSPoolUsageArgsForCall(i int) context.Context {
+	fake.getFSPoolUsageMutex.RLock()
+	defer fake.getFSPoolUsageMutex.RUnlock()
+	argsForCall := fake.getFSPoolUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClient) GetFSPoolUsageReturns(result1 []lxf.FSPoolUsage, result2 error) {
 	fake.getFSPoolUsageMutex.Lock()
 	defer fake.getFSPoolUsageMutex.Unlock()
@@ -398,18 +487,19 @@ func (fake *FakeClient) GetFSPoolUsageReturnsOnCall(i int, result1 []lxf.FSPoolU
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetImage(arg1 string) (*lxf.Image, error) {
+func (fake *FakeClient) GetImage(arg1 context.Context, arg2 string) (*lxf.Image, error) {
 	fake.getImageMutex.Lock()
 	ret, specificReturn := fake.getImageReturnsOnCall[len(fake.getImageArgsForCall)]
 	fake.getImageArgsForCall = append(fake.getImageArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetImageStub
 	fakeReturns := fake.getImageReturns
-	fake.recordInvocation("GetImage", []interface{}{arg1})
+	fake.recordInvocation("GetImage", []interface{}{arg1, arg2})
 	fake.getImageMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -423,17 +513,17 @@ func (fake *FakeClient) GetImageCallCount() int {
 	return len(fake.getImageArgsForCall)
 }
 
-func (fake *FakeClient) GetImageCalls(stub func(string) (*lxf.Image, error)) {
+func (fake *FakeClient) GetImageCalls(stub func(context.Context, string) (*lxf.Image, error)) {
 	fake.getImageMutex.Lock()
 	defer fake.getImageMutex.Unlock()
 	fake.GetImageStub = stub
 }
 
-func (fake *FakeClient) GetImageArgsForCall(i int) string {
+func (fake *FakeClient) GetImageArgsForCall(i int) (context.Context, string) {
 	fake.getImageMutex.RLock()
 	defer fake.getImageMutex.RUnlock()
 	argsForCall := fake.getImageArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) GetImageReturns(result1 *lxf.Image, result2 error) {
@@ -462,17 +552,84 @@ func (fake *FakeClient) GetImageReturnsOnCall(i int, result1 *lxf.Image, result2
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetRuntimeInfo() (*lxf.RuntimeInfo, error) {
+func (fake *FakeClient) GetImageVariant(arg1 context.Context, arg2 string, arg3 lxf.InstanceType) (*lxf.Image, error) {
+	fake.getImageVariantMutex.Lock()
+	ret, specificReturn := fake.getImageVariantReturnsOnCall[len(fake.getImageVariantArgsForCall)]
+	fake.getImageVariantArgsForCall = append(fake.getImageVariantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 lxf.InstanceType
+	}{arg1, arg2, arg3})
+	stub := fake.GetImageVariantStub
+	fakeReturns := fake.getImageVariantReturns
+	fake.recordInvocation("GetImageVariant", []interface{}{arg1, arg2, arg3})
+	fake.getImageVariantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetImageVariantCallCount() int {
+	fake.getImageVariantMutex.RLock()
+	defer fake.getImageVariantMutex.RUnlock()
+	return len(fake.getImageVariantArgsForCall)
+}
+
+func (fake *FakeClient) GetImageVariantCalls(stub func(context.Context, string, lxf.InstanceType) (*lxf.Image, error)) {
+	fake.getImageVariantMutex.Lock()
+	defer fake.getImageVariantMutex.Unlock()
+	fake.GetImageVariantStub = stub
+}
+
+func (fake *FakeClient) GetImageVariantArgsForCall(i int) (context.Context, string, lxf.InstanceType) {
+	fake.getImageVariantMutex.RLock()
+	defer fake.getImageVariantMutex.RUnlock()
+	argsForCall := fake.getImageVariantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetImageVariantReturns(result1 *lxf.Image, result2 error) {
+	fake.getImageVariantMutex.Lock()
+	defer fake.getImageVariantMutex.Unlock()
+	fake.GetImageVariantStub = nil
+	fake.getImageVariantReturns = struct {
+		result1 *lxf.Image
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetImageVariantReturnsOnCall(i int, result1 *lxf.Image, result2 error) {
+	fake.getImageVariantMutex.Lock()
+	defer fake.getImageVariantMutex.Unlock()
+	fake.GetImageVariantStub = nil
+	if fake.getImageVariantReturnsOnCall == nil {
+		fake.getImageVariantReturnsOnCall = make(map[int]struct {
+			result1 *lxf.Image
+			result2 error
+		})
+	}
+	fake.getImageVariantReturnsOnCall[i] = struct {
+		result1 *lxf.Image
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetRuntimeInfo(arg1 context.Context) (*lxf.RuntimeInfo, error) {
 	fake.getRuntimeInfoMutex.Lock()
 	ret, specificReturn := fake.getRuntimeInfoReturnsOnCall[len(fake.getRuntimeInfoArgsForCall)]
 	fake.getRuntimeInfoArgsForCall = append(fake.getRuntimeInfoArgsForCall, struct {
-	}{})
+		arg1 context.Context
+	}{arg1})
 	stub := fake.GetRuntimeInfoStub
 	fakeReturns := fake.getRuntimeInfoReturns
-	fake.recordInvocation("GetRuntimeInfo", []interface{}{})
+	fake.recordInvocation("GetRuntimeInfo", []interface{}{arg1})
 	fake.getRuntimeInfoMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -486,12 +643,19 @@ func (fake *FakeClient) GetRuntimeInfoCallCount() int {
 	return len(fake.getRuntimeInfoArgsForCall)
 }
 
-func (fake *FakeClient) GetRuntimeInfoCalls(stub func() (*lxf.RuntimeInfo, error)) {
+func (fake *FakeClient) GetRuntimeInfoCalls(stub func(context.Context) (*lxf.RuntimeInfo, error)) {
 	fake.getRuntimeInfoMutex.Lock()
 	defer fake.getRuntimeInfoMutex.Unlock()
 	fake.GetRuntimeInfoStub = stub
 }
 
+func (fake *FakeClient) GetRuntimeInfoArgsForCall(i int) context.Context {
+	fake.getRuntimeInfoMutex.RLock()
+	defer fake.getRuntimeInfoMutex.RUnlock()
+	argsForCall := fake.getRuntimeInfoArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClient) GetRuntimeInfoReturns(result1 *lxf.RuntimeInfo, result2 error) {
 	fake.getRuntimeInfoMutex.Lock()
 	defer fake.getRuntimeInfoMutex.Unlock()
@@ -518,18 +682,19 @@ func (fake *FakeClient) GetRuntimeInfoReturnsOnCall(i int, result1 *lxf.RuntimeI
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetSandbox(arg1 string) (*lxf.Sandbox, error) {
+func (fake *FakeClient) GetSandbox(arg1 context.Context, arg2 string) (*lxf.Sandbox, error) {
 	fake.getSandboxMutex.Lock()
 	ret, specificReturn := fake.getSandboxReturnsOnCall[len(fake.getSandboxArgsForCall)]
 	fake.getSandboxArgsForCall = append(fake.getSandboxArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetSandboxStub
 	fakeReturns := fake.getSandboxReturns
-	fake.recordInvocation("GetSandbox", []interface{}{arg1})
+	fake.recordInvocation("GetSandbox", []interface{}{arg1, arg2})
 	fake.getSandboxMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -543,17 +708,17 @@ func (fake *FakeClient) GetSandboxCallCount() int {
 	return len(fake.getSandboxArgsForCall)
 }
 
-func (fake *FakeClient) GetSandboxCalls(stub func(string) (*lxf.Sandbox, error)) {
+func (fake *FakeClient) GetSandboxCalls(stub func(context.Context, string) (*lxf.Sandbox, error)) {
 	fake.getSandboxMutex.Lock()
 	defer fake.getSandboxMutex.Unlock()
 	fake.GetSandboxStub = stub
 }
 
-func (fake *FakeClient) GetSandboxArgsForCall(i int) string {
+func (fake *FakeClient) GetSandboxArgsForCall(i int) (context.Context, string) {
 	fake.getSandboxMutex.RLock()
 	defer fake.getSandboxMutex.RUnlock()
 	argsForCall := fake.getSandboxArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) GetSandboxReturns(result1 *lxf.Sandbox, result2 error) {
@@ -582,7 +747,7 @@ func (fake *FakeClient) GetSandboxReturnsOnCall(i int, result1 *lxf.Sandbox, res
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetServer() lxd.ContainerServer {
+func (fake *FakeClient) GetServer() lxd.InstanceServer {
 	fake.getServerMutex.Lock()
 	ret, specificReturn := fake.getServerReturnsOnCall[len(fake.getServerArgsForCall)]
 	fake.getServerArgsForCall = append(fake.getServerArgsForCall, struct {
@@ -606,46 +771,47 @@ func (fake *FakeClient) GetServerCallCount() int {
 	return len(fake.getServerArgsForCall)
 }
 
-func (fake *FakeClient) GetServerCalls(stub func() lxd.ContainerServer) {
+func (fake *FakeClient) GetServerCalls(stub func() lxd.InstanceServer) {
 	fake.getServerMutex.Lock()
 	defer fake.getServerMutex.Unlock()
 	fake.GetServerStub = stub
 }
 
-func (fake *FakeClient) GetServerReturns(result1 lxd.ContainerServer) {
+func (fake *FakeClient) GetServerReturns(result1 lxd.InstanceServer) {
 	fake.getServerMutex.Lock()
 	defer fake.getServerMutex.Unlock()
 	fake.GetServerStub = nil
 	fake.getServerReturns = struct {
-		result1 lxd.ContainerServer
+		result1 lxd.InstanceServer
 	}{result1}
 }
 
-func (fake *FakeClient) GetServerReturnsOnCall(i int, result1 lxd.ContainerServer) {
+func (fake *FakeClient) GetServerReturnsOnCall(i int, result1 lxd.InstanceServer) {
 	fake.getServerMutex.Lock()
 	defer fake.getServerMutex.Unlock()
 	fake.GetServerStub = nil
 	if fake.getServerReturnsOnCall == nil {
 		fake.getServerReturnsOnCall = make(map[int]struct {
-			result1 lxd.ContainerServer
+			result1 lxd.InstanceServer
 		})
 	}
 	fake.getServerReturnsOnCall[i] = struct {
-		result1 lxd.ContainerServer
+		result1 lxd.InstanceServer
 	}{result1}
 }
 
-func (fake *FakeClient) ListContainers() ([]*lxf.Container, error) {
+func (fake *FakeClient) ListContainers(arg1 context.Context) ([]*lxf.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
 	fake.listContainersArgsForCall = append(fake.listContainersArgsForCall, struct {
-	}{})
+		arg1 context.Context
+	}{arg1})
 	stub := fake.ListContainersStub
 	fakeReturns := fake.listContainersReturns
-	fake.recordInvocation("ListContainers", []interface{}{})
+	fake.recordInvocation("ListContainers", []interface{}{arg1})
 	fake.listContainersMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -659,12 +825,19 @@ func (fake *FakeClient) ListContainersCallCount() int {
 	return len(fake.listContainersArgsForCall)
 }
 
-func (fake *FakeClient) ListContainersCalls(stub func() ([]*lxf.Container, error)) {
+func (fake *FakeClient) ListContainersCalls(stub func(context.Context) ([]*lxf.Container, error)) {
 	fake.listContainersMutex.Lock()
 	defer fake.listContainersMutex.Unlock()
 	fake.ListContainersStub = stub
 }
 
+func (fake *FakeClient) ListContainersArgsForCall(i int) context.Context {
+	fake.listContainersMutex.RLock()
+	defer fake.listContainersMutex.RUnlock()
+	argsForCall := fake.listContainersArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClient) ListContainersReturns(result1 []*lxf.Container, result2 error) {
 	fake.listContainersMutex.Lock()
 	defer fake.listContainersMutex.Unlock()
@@ -691,18 +864,19 @@ func (fake *FakeClient) ListContainersReturnsOnCall(i int, result1 []*lxf.Contai
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListImages(arg1 string) ([]*lxf.Image, error) {
+func (fake *FakeClient) ListImages(arg1 context.Context, arg2 string) ([]*lxf.Image, error) {
 	fake.listImagesMutex.Lock()
 	ret, specificReturn := fake.listImagesReturnsOnCall[len(fake.listImagesArgsForCall)]
 	fake.listImagesArgsForCall = append(fake.listImagesArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.ListImagesStub
 	fakeReturns := fake.listImagesReturns
-	fake.recordInvocation("ListImages", []interface{}{arg1})
+	fake.recordInvocation("ListImages", []interface{}{arg1, arg2})
 	fake.listImagesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -716,17 +890,17 @@ func (fake *FakeClient) ListImagesCallCount() int {
 	return len(fake.listImagesArgsForCall)
 }
 
-func (fake *FakeClient) ListImagesCalls(stub func(string) ([]*lxf.Image, error)) {
+func (fake *FakeClient) ListImagesCalls(stub func(context.Context, string) ([]*lxf.Image, error)) {
 	fake.listImagesMutex.Lock()
 	defer fake.listImagesMutex.Unlock()
 	fake.ListImagesStub = stub
 }
 
-func (fake *FakeClient) ListImagesArgsForCall(i int) string {
+func (fake *FakeClient) ListImagesArgsForCall(i int) (context.Context, string) {
 	fake.listImagesMutex.RLock()
 	defer fake.listImagesMutex.RUnlock()
 	argsForCall := fake.listImagesArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) ListImagesReturns(result1 []*lxf.Image, result2 error) {
@@ -755,17 +929,18 @@ func (fake *FakeClient) ListImagesReturnsOnCall(i int, result1 []*lxf.Image, res
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListSandboxes() ([]*lxf.Sandbox, error) {
+func (fake *FakeClient) ListSandboxes(arg1 context.Context) ([]*lxf.Sandbox, error) {
 	fake.listSandboxesMutex.Lock()
 	ret, specificReturn := fake.listSandboxesReturnsOnCall[len(fake.listSandboxesArgsForCall)]
 	fake.listSandboxesArgsForCall = append(fake.listSandboxesArgsForCall, struct {
-	}{})
+		arg1 context.Context
+	}{arg1})
 	stub := fake.ListSandboxesStub
 	fakeReturns := fake.listSandboxesReturns
-	fake.recordInvocation("ListSandboxes", []interface{}{})
+	fake.recordInvocation("ListSandboxes", []interface{}{arg1})
 	fake.listSandboxesMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -779,12 +954,19 @@ func (fake *FakeClient) ListSandboxesCallCount() int {
 	return len(fake.listSandboxesArgsForCall)
 }
 
-func (fake *FakeClient) ListSandboxesCalls(stub func() ([]*lxf.Sandbox, error)) {
+func (fake *FakeClient) ListSandboxesCalls(stub func(context.Context) ([]*lxf.Sandbox, error)) {
 	fake.listSandboxesMutex.Lock()
 	defer fake.listSandboxesMutex.Unlock()
 	fake.ListSandboxesStub = stub
 }
 
+func (fake *FakeClient) ListSandboxesArgsForCall(i int) context.Context {
+	fake.listSandboxesMutex.RLock()
+	defer fake.listSandboxesMutex.RUnlock()
+	argsForCall := fake.listSandboxesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClient) ListSandboxesReturns(result1 []*lxf.Sandbox, result2 error) {
 	fake.listSandboxesMutex.Lock()
 	defer fake.listSandboxesMutex.Unlock()
@@ -926,18 +1108,21 @@ func (fake *FakeClient) NewSandboxReturnsOnCall(i int, result1 *lxf.Sandbox) {
 	}{result1}
 }
 
-func (fake *FakeClient) PullImage(arg1 string) (string, error) {
+func (fake *FakeClient) PullImage(arg1 context.Context, arg2 string, arg3 lxf.InstanceType, arg4 lxf.ImageSourceCheck) (string, error) {
 	fake.pullImageMutex.Lock()
 	ret, specificReturn := fake.pullImageReturnsOnCall[len(fake.pullImageArgsForCall)]
 	fake.pullImageArgsForCall = append(fake.pullImageArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+		arg3 lxf.InstanceType
+		arg4 lxf.ImageSourceCheck
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.PullImageStub
 	fakeReturns := fake.pullImageReturns
-	fake.recordInvocation("PullImage", []interface{}{arg1})
+	fake.recordInvocation("PullImage", []interface{}{arg1, arg2, arg3, arg4})
 	fake.pullImageMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -951,17 +1136,17 @@ func (fake *FakeClient) PullImageCallCount() int {
 	return len(fake.pullImageArgsForCall)
 }
 
-func (fake *FakeClient) PullImageCalls(stub func(string) (string, error)) {
+func (fake *FakeClient) PullImageCalls(stub func(context.Context, string, lxf.InstanceType, lxf.ImageSourceCheck) (string, error)) {
 	fake.pullImageMutex.Lock()
 	defer fake.pullImageMutex.Unlock()
 	fake.PullImageStub = stub
 }
 
-func (fake *FakeClient) PullImageArgsForCall(i int) string {
+func (fake *FakeClient) PullImageArgsForCall(i int) (context.Context, string, lxf.InstanceType, lxf.ImageSourceCheck) {
 	fake.pullImageMutex.RLock()
 	defer fake.pullImageMutex.RUnlock()
 	argsForCall := fake.pullImageArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) PullImageReturns(result1 string, result2 error) {
@@ -990,18 +1175,153 @@ func (fake *FakeClient) PullImageReturnsOnCall(i int, result1 string, result2 er
 	}{result1, result2}
 }
 
-func (fake *FakeClient) RemoveImage(arg1 string) error {
+func (fake *FakeClient) ReconcileImages(arg1 context.Context, arg2 lxf.ImageReconcileOptions) (*lxf.ImageReconcileReport, error) {
+	fake.reconcileImagesMutex.Lock()
+	ret, specificReturn := fake.reconcileImagesReturnsOnCall[len(fake.reconcileImagesArgsForCall)]
+	fake.reconcileImagesArgsForCall = append(fake.reconcileImagesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lxf.ImageReconcileOptions
+	}{arg1, arg2})
+	stub := fake.ReconcileImagesStub
+	fakeReturns := fake.reconcileImagesReturns
+	fake.recordInvocation("ReconcileImages", []interface{}{arg1, arg2})
+	fake.reconcileImagesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ReconcileImagesCallCount() int {
+	fake.reconcileImagesMutex.RLock()
+	defer fake.reconcileImagesMutex.RUnlock()
+	fake.reconcileObjectsMutex.RLock()
+	defer fake.reconcileObjectsMutex.RUnlock()
+	return len(fake.reconcileImagesArgsForCall)
+}
+
+func (fake *FakeClient) ReconcileImagesCalls(stub func(context.Context, lxf.ImageReconcileOptions) (*lxf.ImageReconcileReport, error)) {
+	fake.reconcileImagesMutex.Lock()
+	defer fake.reconcileImagesMutex.Unlock()
+	fake.ReconcileImagesStub = stub
+}
+
+func (fake *FakeClient) ReconcileImagesArgsForCall(i int) (context.Context, lxf.ImageReconcileOptions) {
+	fake.reconcileImagesMutex.RLock()
+	defer fake.reconcileImagesMutex.RUnlock()
+	fake.reconcileObjectsMutex.RLock()
+	defer fake.reconcileObjectsMutex.RUnlock()
+	argsForCall := fake.reconcileImagesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ReconcileImagesReturns(result1 *lxf.ImageReconcileReport, result2 error) {
+	fake.reconcileImagesMutex.Lock()
+	defer fake.reconcileImagesMutex.Unlock()
+	fake.ReconcileImagesStub = nil
+	fake.reconcileImagesReturns = struct {
+		result1 *lxf.ImageReconcileReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReconcileImagesReturnsOnCall(i int, result1 *lxf.ImageReconcileReport, result2 error) {
+	fake.reconcileImagesMutex.Lock()
+	defer fake.reconcileImagesMutex.Unlock()
+	fake.ReconcileImagesStub = nil
+	if fake.reconcileImagesReturnsOnCall == nil {
+		fake.reconcileImagesReturnsOnCall = make(map[int]struct {
+			result1 *lxf.ImageReconcileReport
+			result2 error
+		})
+	}
+	fake.reconcileImagesReturnsOnCall[i] = struct {
+		result1 *lxf.ImageReconcileReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReconcileObjects(arg1 context.Context, arg2 lxf.ObjectReconcileOptions) (*lxf.ObjectReconcileReport, error) {
+	fake.reconcileObjectsMutex.Lock()
+	ret, specificReturn := fake.reconcileObjectsReturnsOnCall[len(fake.reconcileObjectsArgsForCall)]
+	fake.reconcileObjectsArgsForCall = append(fake.reconcileObjectsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lxf.ObjectReconcileOptions
+	}{arg1, arg2})
+	stub := fake.ReconcileObjectsStub
+	fakeReturns := fake.reconcileObjectsReturns
+	fake.recordInvocation("ReconcileObjects", []interface{}{arg1, arg2})
+	fake.reconcileObjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ReconcileObjectsCallCount() int {
+	fake.reconcileObjectsMutex.RLock()
+	defer fake.reconcileObjectsMutex.RUnlock()
+	return len(fake.reconcileObjectsArgsForCall)
+}
+
+func (fake *FakeClient) ReconcileObjectsCalls(stub func(context.Context, lxf.ObjectReconcileOptions) (*lxf.ObjectReconcileReport, error)) {
+	fake.reconcileObjectsMutex.Lock()
+	defer fake.reconcileObjectsMutex.Unlock()
+	fake.ReconcileObjectsStub = stub
+}
+
+func (fake *FakeClient) ReconcileObjectsArgsForCall(i int) (context.Context, lxf.ObjectReconcileOptions) {
+	fake.reconcileObjectsMutex.RLock()
+	defer fake.reconcileObjectsMutex.RUnlock()
+	argsForCall := fake.reconcileObjectsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ReconcileObjectsReturns(result1 *lxf.ObjectReconcileReport, result2 error) {
+	fake.reconcileObjectsMutex.Lock()
+	defer fake.reconcileObjectsMutex.Unlock()
+	fake.ReconcileObjectsStub = nil
+	fake.reconcileObjectsReturns = struct {
+		result1 *lxf.ObjectReconcileReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReconcileObjectsReturnsOnCall(i int, result1 *lxf.ObjectReconcileReport, result2 error) {
+	fake.reconcileObjectsMutex.Lock()
+	defer fake.reconcileObjectsMutex.Unlock()
+	fake.ReconcileObjectsStub = nil
+	if fake.reconcileObjectsReturnsOnCall == nil {
+		fake.reconcileObjectsReturnsOnCall = make(map[int]struct {
+			result1 *lxf.ObjectReconcileReport
+			result2 error
+		})
+	}
+	fake.reconcileObjectsReturnsOnCall[i] = struct {
+		result1 *lxf.ObjectReconcileReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RemoveImage(arg1 context.Context, arg2 string) error {
 	fake.removeImageMutex.Lock()
 	ret, specificReturn := fake.removeImageReturnsOnCall[len(fake.removeImageArgsForCall)]
 	fake.removeImageArgsForCall = append(fake.removeImageArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.RemoveImageStub
 	fakeReturns := fake.removeImageReturns
-	fake.recordInvocation("RemoveImage", []interface{}{arg1})
+	fake.recordInvocation("RemoveImage", []interface{}{arg1, arg2})
 	fake.removeImageMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1015,17 +1335,17 @@ func (fake *FakeClient) RemoveImageCallCount() int {
 	return len(fake.removeImageArgsForCall)
 }
 
-func (fake *FakeClient) RemoveImageCalls(stub func(string) error) {
+func (fake *FakeClient) RemoveImageCalls(stub func(context.Context, string) error) {
 	fake.removeImageMutex.Lock()
 	defer fake.removeImageMutex.Unlock()
 	fake.RemoveImageStub = stub
 }
 
-func (fake *FakeClient) RemoveImageArgsForCall(i int) string {
+func (fake *FakeClient) RemoveImageArgsForCall(i int) (context.Context, string) {
 	fake.removeImageMutex.RLock()
 	defer fake.removeImageMutex.RUnlock()
 	argsForCall := fake.removeImageArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) RemoveImageReturns(result1 error) {
@@ -1107,6 +1427,128 @@ func (fake *FakeClient) SetEventHandlerArgsForCall(i int) lxf.EventHandler {
 	return argsForCall.arg1
 }
 
+func (fake *FakeClient) SetImageRewriteRules(arg1 lxf.ImageRewriteRules) error {
+	fake.setImageRewriteRulesMutex.Lock()
+	ret, specificReturn := fake.setImageRewriteRulesReturnsOnCall[len(fake.setImageRewriteRulesArgsForCall)]
+	fake.setImageRewriteRulesArgsForCall = append(fake.setImageRewriteRulesArgsForCall, struct {
+		arg1 lxf.ImageRewriteRules
+	}{arg1})
+	stub := fake.SetImageRewriteRulesStub
+	fakeReturns := fake.setImageRewriteRulesReturns
+	fake.recordInvocation("SetImageRewriteRules", []interface{}{arg1})
+	fake.setImageRewriteRulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) SetImageRewriteRulesCallCount() int {
+	fake.setImageRewriteRulesMutex.RLock()
+	defer fake.setImageRewriteRulesMutex.RUnlock()
+	return len(fake.setImageRewriteRulesArgsForCall)
+}
+
+func (fake *FakeClient) SetImageRewriteRulesCalls(stub func(lxf.ImageRewriteRules) error) {
+	fake.setImageRewriteRulesMutex.Lock()
+	defer fake.setImageRewriteRulesMutex.Unlock()
+	fake.SetImageRewriteRulesStub = stub
+}
+
+func (fake *FakeClient) SetImageRewriteRulesArgsForCall(i int) lxf.ImageRewriteRules {
+	fake.setImageRewriteRulesMutex.RLock()
+	defer fake.setImageRewriteRulesMutex.RUnlock()
+	argsForCall := fake.setImageRewriteRulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) SetImageRewriteRulesReturns(result1 error) {
+	fake.setImageRewriteRulesMutex.Lock()
+	defer fake.setImageRewriteRulesMutex.Unlock()
+	fake.SetImageRewriteRulesStub = nil
+	fake.setImageRewriteRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SetImageRewriteRulesReturnsOnCall(i int, result1 error) {
+	fake.setImageRewriteRulesMutex.Lock()
+	defer fake.setImageRewriteRulesMutex.Unlock()
+	fake.SetImageRewriteRulesStub = nil
+	if fake.setImageRewriteRulesReturnsOnCall == nil {
+		fake.setImageRewriteRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setImageRewriteRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Subscribe(arg1 lxf.EventSubscriber) func() {
+	fake.subscribeMutex.Lock()
+	ret, specificReturn := fake.subscribeReturnsOnCall[len(fake.subscribeArgsForCall)]
+	fake.subscribeArgsForCall = append(fake.subscribeArgsForCall, struct {
+		arg1 lxf.EventSubscriber
+	}{arg1})
+	stub := fake.SubscribeStub
+	fakeReturns := fake.subscribeReturns
+	fake.recordInvocation("Subscribe", []interface{}{arg1})
+	fake.subscribeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) SubscribeCallCount() int {
+	fake.subscribeMutex.RLock()
+	defer fake.subscribeMutex.RUnlock()
+	return len(fake.subscribeArgsForCall)
+}
+
+func (fake *FakeClient) SubscribeCalls(stub func(lxf.EventSubscriber) func()) {
+	fake.subscribeMutex.Lock()
+	defer fake.subscribeMutex.Unlock()
+	fake.SubscribeStub = stub
+}
+
+func (fake *FakeClient) SubscribeArgsForCall(i int) lxf.EventSubscriber {
+	fake.subscribeMutex.RLock()
+	defer fake.subscribeMutex.RUnlock()
+	argsForCall := fake.subscribeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) SubscribeReturns(result1 func()) {
+	fake.subscribeMutex.Lock()
+	defer fake.subscribeMutex.Unlock()
+	fake.SubscribeStub = nil
+	fake.subscribeReturns = struct {
+		result1 func()
+	}{result1}
+}
+
+func (fake *FakeClient) SubscribeReturnsOnCall(i int, result1 func()) {
+	fake.subscribeMutex.Lock()
+	defer fake.subscribeMutex.Unlock()
+	fake.SubscribeStub = nil
+	if fake.subscribeReturnsOnCall == nil {
+		fake.subscribeReturnsOnCall = make(map[int]struct {
+			result1 func()
+		})
+	}
+	fake.subscribeReturnsOnCall[i] = struct {
+		result1 func()
+	}{result1}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1118,6 +1560,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.getFSPoolUsageMutex.RUnlock()
 	fake.getImageMutex.RLock()
 	defer fake.getImageMutex.RUnlock()
+	fake.getImageVariantMutex.RLock()
+	defer fake.getImageVariantMutex.RUnlock()
 	fake.getRuntimeInfoMutex.RLock()
 	defer fake.getRuntimeInfoMutex.RUnlock()
 	fake.getSandboxMutex.RLock()
@@ -1136,12 +1580,20 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.newSandboxMutex.RUnlock()
 	fake.pullImageMutex.RLock()
 	defer fake.pullImageMutex.RUnlock()
+	fake.reconcileImagesMutex.RLock()
+	defer fake.reconcileImagesMutex.RUnlock()
+	fake.reconcileObjectsMutex.RLock()
+	defer fake.reconcileObjectsMutex.RUnlock()
 	fake.removeImageMutex.RLock()
 	defer fake.removeImageMutex.RUnlock()
 	fake.setCRITestModeMutex.RLock()
 	defer fake.setCRITestModeMutex.RUnlock()
 	fake.setEventHandlerMutex.RLock()
 	defer fake.setEventHandlerMutex.RUnlock()
+	fake.setImageRewriteRulesMutex.RLock()
+	defer fake.setImageRewriteRulesMutex.RUnlock()
+	fake.subscribeMutex.RLock()
+	defer fake.subscribeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
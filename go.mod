@@ -7,7 +7,6 @@ require (
 	github.com/dionysius/errand v1.1.0
 	github.com/docker/docker v20.10.17+incompatible
 	github.com/emicklei/go-restful v2.16.0+incompatible
-	github.com/fsnotify/fsnotify v1.5.4
 	github.com/ghodss/yaml v1.0.0
 	github.com/golangci/golangci-lint v1.49.0
 	github.com/gorilla/websocket v1.5.0
@@ -67,6 +66,7 @@ require (
 	github.com/fatih/structtag v1.2.0 // indirect
 	github.com/firefart/nonamedreturns v1.0.4 // indirect
 	github.com/flosch/pongo2 v0.0.0-20200913210552-0d938eb266f3 // indirect
+	github.com/fsnotify/fsnotify v1.5.4 // indirect
 	github.com/fzipp/gocyclo v0.6.0 // indirect
 	github.com/go-critic/go-critic v0.6.4 // indirect
 	github.com/go-logr/logr v1.2.0 // indirect
@@ -0,0 +1,105 @@
+package lxf
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/lxc/lxd/shared/osarch"
+)
+
+const (
+	cfgImageArchitecture = "user.lxe.architecture"
+)
+
+var (
+	ErrNoMatchingArchitecture = errors.New("no image for a supported architecture")
+)
+
+// architectures returns the architectures the node can run, ordered by preference. The first is the native one, the
+// others are compatible personalities like armv7l on aarch64.
+func (l *client) architectures() ([]string, error) {
+	server, _, err := l.currentServer().GetServer()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(server.Environment.Architectures) > 0 {
+		return server.Environment.Architectures, nil
+	}
+
+	// older servers might not report them, assume the server runs on the same architecture as we do
+	return localArchitectures()
+}
+
+// localArchitectures returns the architecture of this host followed by its compatible personalities
+func localArchitectures() ([]string, error) {
+	id, err := osarch.ArchitectureGetLocalID()
+	if err != nil {
+		return nil, err
+	}
+
+	archs := []string{}
+
+	personalities, err := osarch.ArchitecturePersonalities(id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range append([]int{id}, personalities...) {
+		name, err := osarch.ArchitectureName(a)
+		if err != nil {
+			return nil, err
+		}
+
+		archs = append(archs, name)
+	}
+
+	return archs, nil
+}
+
+// getRemoteImageForArchitectures looks up the image variant of the instance type for the first matching architecture
+// of archs. If aliasOrFingerprint is not an alias it is used as fingerprint, which already defines the architecture.
+func getRemoteImageForArchitectures(imgServer lxd.ImageServer, aliasOrFingerprint string, typ InstanceType, archs []string) (*api.Image, error) {
+	if typ == "" {
+		typ = InstanceTypeContainer
+	}
+
+	variants, err := imgServer.GetImageAliasArchitectures(string(typ), aliasOrFingerprint)
+	if err != nil {
+		if IsNotFoundError(err) {
+			lxdImg, _, err := imgServer.GetImage(aliasOrFingerprint)
+
+			return lxdImg, err
+		}
+
+		return nil, err
+	}
+
+	for _, arch := range archs {
+		variant, has := variants[arch]
+		if !has {
+			continue
+		}
+
+		lxdImg, _, err := imgServer.GetImage(variant.Target)
+		if err != nil {
+			return nil, err
+		}
+
+		return lxdImg, nil
+	}
+
+	available := make([]string, 0, len(variants))
+	for arch := range variants {
+		available = append(available, arch)
+	}
+
+	sort.Strings(available)
+
+	return nil, fmt.Errorf("%w: '%s' is available for %s but wanted one of %s", ErrNoMatchingArchitecture,
+		aliasOrFingerprint, strings.Join(available, ", "), strings.Join(archs, ", "))
+}
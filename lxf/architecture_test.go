@@ -0,0 +1,93 @@
+package lxf
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getRemoteImageForArchitectures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		typ       InstanceType
+		archs     []string
+		want      string
+		imageType string
+	}{
+		{"native", "", []string{"x86_64", "i686"}, "amd", "container"},
+		{"compatible", InstanceTypeContainer, []string{"aarch64", "armv7l"}, "armhf", "container"},
+		{"preferred", InstanceTypeContainer, []string{"aarch64", "armv7l", "x86_64"}, "armhf", "container"},
+		{"vm", InstanceTypeVirtualMachine, []string{"x86_64"}, "amd", "virtual-machine"},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, fake := testClient()
+
+			fake.GetImageAliasArchitecturesReturns(map[string]*api.ImageAliasesEntry{
+				"x86_64": {ImageAliasesEntryPut: api.ImageAliasesEntryPut{Target: "amd"}},
+				"armv7l": {ImageAliasesEntryPut: api.ImageAliasesEntryPut{Target: "armhf"}},
+			}, nil)
+			fake.GetImageStub = func(fingerprint string) (*api.Image, string, error) {
+				return &api.Image{Fingerprint: fingerprint}, "", nil
+			}
+
+			img, err := getRemoteImageForArchitectures(c.server, "alpine/edge", tt.typ, tt.archs)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, img.Fingerprint)
+
+			imageType, alias := fake.GetImageAliasArchitecturesArgsForCall(0)
+			assert.Equal(t, tt.imageType, imageType)
+			assert.Equal(t, "alpine/edge", alias)
+		})
+	}
+}
+
+func Test_getRemoteImageForArchitectures_NoMatch(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	fake.GetImageAliasArchitecturesReturns(map[string]*api.ImageAliasesEntry{
+		"x86_64": {ImageAliasesEntryPut: api.ImageAliasesEntryPut{Target: "amd"}},
+	}, nil)
+
+	_, err := getRemoteImageForArchitectures(c.server, "alpine/edge", InstanceTypeContainer, []string{"aarch64", "armv7l"})
+	assert.ErrorIs(t, err, ErrNoMatchingArchitecture)
+	assert.Equal(t, 0, fake.GetImageCallCount())
+}
+
+func Test_getRemoteImageForArchitectures_Fingerprint(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	fake.GetImageAliasArchitecturesReturns(nil, api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
+	fake.GetImageReturns(&api.Image{Fingerprint: "abcdefg", Architecture: "x86_64"}, "", nil)
+
+	img, err := getRemoteImageForArchitectures(c.server, "abcdefg", InstanceTypeContainer, []string{"aarch64"})
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdefg", img.Fingerprint)
+	assert.Equal(t, "abcdefg", fake.GetImageArgsForCall(0))
+}
+
+func TestClient_architectures(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	server := &api.Server{}
+	server.Environment.Architectures = []string{"aarch64", "armv7l"}
+	fake.GetServerReturns(server, "", nil)
+
+	archs, err := c.architectures()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aarch64", "armv7l"}, archs)
+}
@@ -0,0 +1,434 @@
+package lxf
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lxc/lxd/shared/api"
+)
+
+// CacheConfig controls the in-memory cache of sandboxes and containers
+type CacheConfig struct {
+	// Enabled serves lookups and lists of sandboxes and containers from memory. It's seeded on connect and kept up to
+	// date by the lifecycle events of LXD. If disabled, every call goes to LXD.
+	Enabled bool
+	// Resync is the interval the cache is reloaded completely from LXD, to recover from missed events. Disabled if 0.
+	Resync time.Duration
+}
+
+// ProfileSelflinkRegex to extract the profilename in selflinks.
+var ProfileSelflinkRegex = regexp.MustCompile(`^/[\d.]+/profiles/([^?]*)(\?.*)?$`)
+
+// cacheKey identifies an object in the cache, project is empty for the project of the connection
+type cacheKey struct {
+	project string
+	name    string
+}
+
+// cachedProfile is a profile as known to the cache. The etag is empty if it's unknown, as lists don't return it, or
+// if the profile was changed by LXE and has to be fetched again.
+type cachedProfile struct {
+	profile api.Profile
+	etag    string
+}
+
+// cachedContainer is a container as known to the cache, see cachedProfile for the etag
+type cachedContainer struct {
+	container api.Instance
+	etag      string
+}
+
+// objectCache holds the profiles and containers of all projects. All methods can be called on a nil cache, which
+// acts as an always empty cache.
+type objectCache struct {
+	mu         sync.RWMutex
+	synced     bool
+	profiles   map[cacheKey]cachedProfile
+	containers map[cacheKey]cachedContainer
+}
+
+func newObjectCache() *objectCache {
+	return &objectCache{
+		profiles:   map[cacheKey]cachedProfile{},
+		containers: map[cacheKey]cachedContainer{},
+	}
+}
+
+// isSynced reports whether the cache has been seeded and can answer lists
+func (c *objectCache) isSynced() bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.synced
+}
+
+// replace the whole content of the cache. Etags of unchanged objects are kept.
+func (c *objectCache) replace(profiles map[cacheKey]api.Profile, containers map[cacheKey]api.Instance) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	newProfiles := make(map[cacheKey]cachedProfile, len(profiles))
+
+	for k, p := range profiles {
+		cp := cachedProfile{profile: p}
+		if old, has := c.profiles[k]; has && reflect.DeepEqual(old.profile.Writable(), p.Writable()) {
+			cp.etag = old.etag
+		}
+
+		newProfiles[k] = cp
+	}
+
+	newContainers := make(map[cacheKey]cachedContainer, len(containers))
+
+	for k, ct := range containers {
+		cc := cachedContainer{container: ct}
+		if old, has := c.containers[k]; has && reflect.DeepEqual(old.container.Writable(), ct.Writable()) &&
+			old.container.StatusCode == ct.StatusCode {
+			cc.etag = old.etag
+		}
+
+		newContainers[k] = cc
+	}
+
+	c.profiles = newProfiles
+	c.containers = newContainers
+	c.synced = true
+}
+
+// getProfile returns the cached profile, ok is false if it's not cached or the etag is unknown
+func (c *objectCache) getProfile(project, name string) (p api.Profile, etag string, ok bool) {
+	if c == nil {
+		return p, "", false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cp, has := c.profiles[cacheKey{project, name}]
+	if !has || cp.etag == "" {
+		return p, "", false
+	}
+
+	cp.profile.UsedBy = c.usedBy(project, name)
+
+	return cp.profile, cp.etag, true
+}
+
+// getContainer returns the cached container, ok is false if it's not cached or the etag is unknown
+func (c *objectCache) getContainer(project, name string) (ct api.Instance, etag string, ok bool) {
+	if c == nil {
+		return ct, "", false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cc, has := c.containers[cacheKey{project, name}]
+	if !has || cc.etag == "" {
+		return ct, "", false
+	}
+
+	return cc.container, cc.etag, true
+}
+
+func (c *objectCache) storeProfile(project string, p api.Profile, etag string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.profiles[cacheKey{project, p.Name}] = cachedProfile{profile: p, etag: etag}
+}
+
+func (c *objectCache) storeContainer(project string, ct api.Instance, etag string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.containers[cacheKey{project, ct.Name}] = cachedContainer{container: ct, etag: etag}
+}
+
+func (c *objectCache) removeProfile(project, name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.profiles, cacheKey{project, name})
+}
+
+func (c *objectCache) removeContainer(project, name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.containers, cacheKey{project, name})
+}
+
+// invalidateProfile forgets the etag, so the next lookup fetches the profile from LXD again
+func (c *objectCache) invalidateProfile(project, name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := cacheKey{project, name}
+	if cp, has := c.profiles[k]; has {
+		cp.etag = ""
+		c.profiles[k] = cp
+	}
+}
+
+// invalidateContainer forgets the etag, so the next lookup fetches the container from LXD again
+func (c *objectCache) invalidateContainer(project, name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := cacheKey{project, name}
+	if cc, has := c.containers[k]; has {
+		cc.etag = ""
+		c.containers[k] = cc
+	}
+}
+
+// listProfiles returns all cached profiles ordered by project and name
+func (c *objectCache) listProfiles() ([]cacheKey, []api.Profile) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]cacheKey, 0, len(c.profiles))
+	for k := range c.profiles {
+		keys = append(keys, k)
+	}
+
+	sortCacheKeys(keys)
+
+	profiles := make([]api.Profile, 0, len(keys))
+
+	for _, k := range keys {
+		p := c.profiles[k].profile
+		p.UsedBy = c.usedBy(k.project, k.name)
+		profiles = append(profiles, p)
+	}
+
+	return keys, profiles
+}
+
+// listContainers returns all cached containers ordered by project and name
+func (c *objectCache) listContainers() ([]cacheKey, []api.Instance) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]cacheKey, 0, len(c.containers))
+	for k := range c.containers {
+		keys = append(keys, k)
+	}
+
+	sortCacheKeys(keys)
+
+	containers := make([]api.Instance, 0, len(keys))
+	for _, k := range keys {
+		containers = append(containers, c.containers[k].container)
+	}
+
+	return keys, containers
+}
+
+// usedBy returns the selflinks of the cached containers of the project using the profile. The UsedBy of the profile
+// itself isn't updated by LXD events when containers are created or deleted. Must be called with the lock held.
+func (c *objectCache) usedBy(project, profile string) []string {
+	usedBy := []string{}
+
+	for k, cc := range c.containers {
+		if k.project != project {
+			continue
+		}
+
+		for _, p := range cc.container.Profiles {
+			if p == profile {
+				usedBy = append(usedBy, fmt.Sprintf("/1.0/instances/%s", k.name))
+
+				break
+			}
+		}
+	}
+
+	sort.Strings(usedBy)
+
+	return usedBy
+}
+
+func sortCacheKeys(keys []cacheKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].project != keys[j].project {
+			return keys[i].project < keys[j].project
+		}
+
+		return keys[i].name < keys[j].name
+	})
+}
+
+// resyncCache reloads all profiles and containers of all projects into the cache
+func (l *client) resyncCache() error {
+	if l.cache == nil {
+		return nil
+	}
+
+	projects, err := l.projects()
+	if err != nil {
+		return err
+	}
+
+	profiles := map[cacheKey]api.Profile{}
+	containers := map[cacheKey]api.Instance{}
+
+	for _, project := range projects {
+		server := l.projectServer(project)
+
+		ps, err := server.GetProfiles()
+		if err != nil {
+			return err
+		}
+
+		for _, p := range ps {
+			profiles[cacheKey{project, p.Name}] = p
+			l.indexObject(p.Name, project)
+		}
+
+		cts, err := server.GetInstances(api.InstanceTypeAny)
+		if err != nil {
+			return err
+		}
+
+		for _, ct := range cts {
+			containers[cacheKey{project, ct.Name}] = ct
+			l.indexObject(ct.Name, project)
+		}
+	}
+
+	l.cache.replace(profiles, containers)
+
+	return nil
+}
+
+// runCacheResync reloads the cache periodically
+func (l *client) runCacheResync(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		err := l.resyncCache()
+		if err != nil {
+			log.WithError(err).Error("failed to resync cache")
+		}
+	}
+}
+
+// fetchProfile gets the profile from LXD and updates the cache
+func (l *client) fetchProfile(project, name string) (*api.Profile, string, error) {
+	p, etag, err := l.projectServer(project).GetProfile(name)
+	if err != nil {
+		if IsNotFoundError(err) {
+			l.cache.removeProfile(project, name)
+		}
+
+		return nil, "", err
+	}
+
+	l.cache.storeProfile(project, *p, etag)
+
+	return p, etag, nil
+}
+
+// fetchContainer gets the container from LXD and updates the cache
+func (l *client) fetchContainer(project, name string) (*api.Instance, string, error) {
+	ct, etag, err := l.projectServer(project).GetInstance(name)
+	if err != nil {
+		if IsNotFoundError(err) {
+			l.cache.removeContainer(project, name)
+		}
+
+		return nil, "", err
+	}
+
+	l.cache.storeContainer(project, *ct, etag)
+
+	return ct, etag, nil
+}
+
+// updateCache applies a lifecycle event of LXD to the cache. Changed objects are fetched again, as events only tell
+// which object has changed.
+func (l *client) updateCache(eventProject string, event api.EventLifecycle) {
+	if l.cache == nil {
+		return
+	}
+
+	project := ""
+	if l.conn.NamespaceProjects.isNamespaceProject(eventProject) {
+		project = eventProject
+	}
+
+	var err error
+
+	switch event.Action {
+	case api.EventLifecycleProfileCreated, api.EventLifecycleProfileUpdated:
+		name := getProfileIDFromSelflink(event.Source)
+		l.indexObject(name, project)
+		_, _, err = l.fetchProfile(project, name)
+	case api.EventLifecycleProfileDeleted:
+		l.cache.removeProfile(project, getProfileIDFromSelflink(event.Source))
+	case api.EventLifecycleInstanceCreated, api.EventLifecycleInstanceUpdated, api.EventLifecycleInstanceStarted,
+		api.EventLifecycleInstanceStopped, api.EventLifecycleInstanceRestarted, api.EventLifecycleInstanceShutdown:
+		name := GetContainerIDFromSelflink(event.Source)
+		l.indexObject(name, project)
+		_, _, err = l.fetchContainer(project, name)
+	case api.EventLifecycleInstanceDeleted:
+		l.cache.removeContainer(project, GetContainerIDFromSelflink(event.Source))
+	case api.EventLifecycleProfileRenamed, api.EventLifecycleInstanceRenamed:
+		// the old name isn't known from the source, so everything is reloaded
+		err = l.resyncCache()
+	}
+
+	if err != nil && !IsNotFoundError(err) {
+		log.WithError(err).WithField("event", event.Action).Error("failed to update cache")
+	}
+}
+
+// Returns the profilename from a selflink address. Returns empty if not found
+func getProfileIDFromSelflink(path string) string {
+	matches := ProfileSelflinkRegex.FindStringSubmatch(path)
+
+	if len(matches) > 1 {
+		return matches[1]
+	}
+
+	return ""
+}
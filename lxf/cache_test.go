@@ -0,0 +1,154 @@
+package lxf
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCacheClient() (*client, *lxdfakes.FakeInstanceServer) {
+	c, fake := testClient()
+	c.cache = newObjectCache()
+
+	p := getSchemaProfile(SchemaVersionProfile)
+	p.Name = "sandbox"
+	satisfyProfileCri(&p)
+
+	ct := getSchemaContainer(SchemaVersionContainer)
+	ct.Name = "container"
+	ct.Profiles = []string{"sandbox"}
+	satisfyContainerCri(&ct)
+
+	fake.GetProfilesReturns([]api.Profile{p}, nil)
+	fake.GetInstancesReturns([]api.Instance{ct}, nil)
+	fake.GetProfileReturns(&p, "etag", nil)
+	fake.GetInstanceReturns(&ct, "etag", nil)
+
+	return c, fake
+}
+
+func TestClient_Cache_List(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testCacheClient()
+
+	err := c.resyncCache()
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		sl, err := c.ListSandboxes(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, sl, 1)
+		assert.Equal(t, []string{"container"}, sl[0].UsedBy)
+
+		cl, err := c.ListContainers(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, cl, 1)
+	}
+
+	// only the resync asked LXD
+	assert.Equal(t, 1, fake.GetProfilesCallCount())
+}
+
+func TestClient_Cache_GetAndInvalidate(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	c.cache = newObjectCache()
+
+	ct := getSchemaContainer(SchemaVersionContainer)
+	ct.Name = "container"
+	ct.Profiles = []string{"sandbox"}
+	fake.GetInstanceReturns(satisfyContainerCri(&ct), "etag", nil)
+
+	// the etag is unknown after a resync, so the first lookup asks LXD
+	fake.GetInstancesReturns([]api.Instance{ct}, nil)
+	assert.NoError(t, c.resyncCache())
+
+	for i := 0; i < 2; i++ {
+		cnt, err := c.GetContainer(context.Background(), "container")
+		assert.NoError(t, err)
+		assert.Equal(t, "etag", cnt.ETag)
+	}
+
+	assert.Equal(t, 1, fake.GetInstanceCallCount())
+
+	c.cache.invalidateContainer("", "container")
+
+	_, err := c.GetContainer(context.Background(), "container")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fake.GetInstanceCallCount())
+
+	// an unchanged container keeps its etag on resync
+	assert.NoError(t, c.resyncCache())
+
+	_, err = c.GetContainer(context.Background(), "container")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fake.GetInstanceCallCount())
+}
+
+func TestClient_Cache_Bypass(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	p := getSchemaProfile(SchemaVersionProfile)
+	fake.GetProfileReturns(satisfyProfileCri(&p), "etag", nil)
+
+	for i := 0; i < 2; i++ {
+		_, err := c.GetSandbox(context.Background(), "sandbox")
+		assert.NoError(t, err)
+
+		_, err = c.ListSandboxes(context.Background())
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, fake.GetProfileCallCount())
+	assert.Equal(t, 2, fake.GetProfilesCallCount())
+}
+
+func TestClient_updateCache(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	c.cache = newObjectCache()
+	assert.NoError(t, c.resyncCache())
+
+	ct := getSchemaContainer(SchemaVersionContainer)
+	ct.Name = "container"
+	fake.GetInstanceReturns(&ct, "etag", nil)
+
+	c.updateCache("default", api.EventLifecycle{Action: api.EventLifecycleInstanceCreated, Source: "/1.0/instances/container"})
+
+	_, etag, ok := c.cache.getContainer("", "container")
+	assert.True(t, ok)
+	assert.Equal(t, "etag", etag)
+
+	c.updateCache("default", api.EventLifecycle{Action: api.EventLifecycleInstanceDeleted, Source: "/1.0/instances/container"})
+
+	_, _, ok = c.cache.getContainer("", "container")
+	assert.False(t, ok)
+
+	p := getSchemaProfile(SchemaVersionProfile)
+	p.Name = "sandbox"
+	fake.GetProfileReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Profile not found"))
+	c.cache.storeProfile("", p, "etag")
+
+	// the profile was deleted in the meantime
+	c.updateCache("default", api.EventLifecycle{Action: api.EventLifecycleProfileUpdated, Source: "/1.0/profiles/sandbox"})
+
+	_, _, ok = c.cache.getProfile("", "sandbox")
+	assert.False(t, ok)
+}
+
+func Test_getProfileIDFromSelflink(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "foo", getProfileIDFromSelflink("/1.0/profiles/foo?project=k8s-bar"))
+	assert.Equal(t, "foo", getProfileIDFromSelflink("/1.0/profiles/foo"))
+	assert.Equal(t, "", getProfileIDFromSelflink("/1.0/instances/foo"))
+}
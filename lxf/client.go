@@ -5,12 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
-	"path"
+	"sync"
 	"time"
 
 	"github.com/automaticserver/lxe/lxf/lxo"
-	"github.com/fsnotify/fsnotify"
 	lxd "github.com/lxc/lxd/client"
 	"github.com/lxc/lxd/lxc/config"
 	"github.com/sirupsen/logrus"
@@ -24,47 +22,60 @@ var (
 	ErrUsage       = errors.New("usage error")
 )
 
-// Client is a facade to thin the interface to map the cri logic to lxd.
+// Client is a facade to thin the interface to map the cri logic to lxd. LXD operations waited for are cancelled when
+// the context passed along is done.
 type Client interface {
 	// GetServer returns the lxd ContainerServer. TODO: since it created it and others want to access lxd too (lxdbridge
 	// network plugin) either return it here, or extract creation of the connection outside and pass server into
 	// NewClient(), but that makes the initialisation NewClient() pretty unnecessary
-	GetServer() lxd.ContainerServer
+	GetServer() lxd.InstanceServer
 	// GetRuntimeInfo returns informations about the runtime
-	GetRuntimeInfo() (*RuntimeInfo, error)
+	GetRuntimeInfo(ctx context.Context) (*RuntimeInfo, error)
 	// SetEventHandler for container's starting and stopping events
 	SetEventHandler(eh EventHandler)
+	// Subscribe to the lifecycle events of containers and return the function to unsubscribe again
+	Subscribe(fn EventSubscriber) func()
 	// SetCRITestMode enables the critest mode
 	SetCRITestMode()
+	// SetImageRewriteRules sets the rules to rewrite requested images when pulling
+	SetImageRewriteRules(rules ImageRewriteRules) error
 
-	// PullImage copies the given image from the remote server
-	PullImage(image string) (string, error)
+	// PullImage copies the given image for the instance type from the remote server, skipping the sources rejected by
+	// check
+	PullImage(ctx context.Context, image string, typ InstanceType, check ImageSourceCheck) (string, error)
 	// RemoveImage will remove a pulled image
-	RemoveImage(image string) error
+	RemoveImage(ctx context.Context, image string) error
 	// ListImages will list all pulled images
-	ListImages(filter string) ([]*Image, error)
+	ListImages(ctx context.Context, filter string) ([]*Image, error)
 	// GetImage will fetch information about a pulled image
-	GetImage(image string) (*Image, error)
+	GetImage(ctx context.Context, image string) (*Image, error)
+	// GetImageVariant returns the image pulled for the instance type
+	GetImageVariant(ctx context.Context, image string, typ InstanceType) (*Image, error)
+	// ReconcileImages finds dangling aliases, unused and duplicate images and optionally removes them
+	ReconcileImages(ctx context.Context, opts ImageReconcileOptions) (*ImageReconcileReport, error)
+	// ReconcileObjects finds empty sandboxes, orphaned and outdated objects and optionally removes the orphaned and
+	// outdated ones
+	ReconcileObjects(ctx context.Context, opts ObjectReconcileOptions) (*ObjectReconcileReport, error)
 	// GetFSPoolUsage returns a list of usage information about the used storage pools
-	GetFSPoolUsage() ([]FSPoolUsage, error)
+	GetFSPoolUsage(ctx context.Context) ([]FSPoolUsage, error)
 
 	// NewSandbox creates a local representation of a sandbox
 	NewSandbox() *Sandbox
 	// GetSandbox will find a sandbox by id and return it.
-	GetSandbox(id string) (*Sandbox, error)
+	GetSandbox(ctx context.Context, id string) (*Sandbox, error)
 	// ListSandboxes will return a list with all the available sandboxes
-	ListSandboxes() ([]*Sandbox, error)
+	ListSandboxes(ctx context.Context) ([]*Sandbox, error)
 
 	// NewContainer creates a local representation of a container
 	NewContainer(sandboxID string, additionalProfiles ...string) *Container
 	// GetContainer returns the container identified by id
-	GetContainer(id string) (*Container, error)
+	GetContainer(ctx context.Context, id string) (*Container, error)
 	// ListContainers returns a list of all available containers
-	ListContainers() ([]*Container, error)
+	ListContainers(ctx context.Context) ([]*Container, error)
 
 	// Exec will start a command on the server and attach the provided streams. It will block till the command terminated
 	// AND all data was written to stdout/stdin. The caller is responsible to provide a sink which doesn't block.
-	Exec(cid string, cmd []string, stdin io.ReadCloser, stdout, stderr io.WriteCloser, interactive, tty bool, timeout int64, resize <-chan remotecommand.TerminalSize) (int32, error)
+	Exec(ctx context.Context, cid string, cmd []string, stdin io.ReadCloser, stdout, stderr io.WriteCloser, interactive, tty bool, timeout int64, resize <-chan remotecommand.TerminalSize) (int32, error)
 }
 
 var (
@@ -73,16 +84,27 @@ var (
 )
 
 type client struct {
-	server       lxd.ContainerServer
+	// connMu guards server and opwait, which are replaced when reconnecting
+	connMu       sync.RWMutex
+	server       lxd.InstanceServer
 	config       *config.Config
 	opwait       *lxo.LXO
-	eventHandler EventHandler
-	socket       string
+	events       *eventBus
+	conn         ConnectionConfig
 	critestMode  bool
+	rewriteRules ImageRewriteRules
+	projectIndex *projectIndex
+	cache        *objectCache
+	locks        *objectLocks
 }
 
-// NewClient will set up a connection and return the client
-func NewClient(socket string, configPath string) (Client, error) { // nolint: ireturn
+// NewClient will set up a connection and return the client. The connection is reestablished if it gets lost.
+func NewClient(conn ConnectionConfig, configPath string) (Client, error) { // nolint: ireturn
+	err := validateConnection(conn)
+	if err != nil {
+		return nil, err
+	}
+
 	config, err := config.LoadConfig(configPath)
 	if err != nil {
 		return nil, err
@@ -90,31 +112,79 @@ func NewClient(socket string, configPath string) (Client, error) { // nolint: ir
 
 	log.WithField("remotes", config.Remotes).Debug("loaded remote config")
 
-	cl := &client{
-		config: config,
-		socket: socket,
-	}
+	cl := newClient(conn, config)
 
 	err = cl.connect()
 	if err != nil {
 		return nil, err
 	}
 
-	go cl.detectNeedReconnect()
+	if cl.cache != nil && conn.Cache.Resync > 0 {
+		go cl.runCacheResync(conn.Cache.Resync)
+	}
+
+	return cl, nil
+}
+
+// NewClientWithServer returns a client using the already connected server. Neither the events of the server are
+// received nor is it reconnected, the project of conn must already be set on the server. Used to run against a fake
+// server.
+func NewClientWithServer(server lxd.InstanceServer, conn ConnectionConfig) (Client, error) { // nolint: ireturn
+	err := validateConnection(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := newClient(conn, &config.Config{})
+	cl.useServer(server)
 
 	return cl, nil
 }
 
+func validateConnection(conn ConnectionConfig) error {
+	err := conn.NamespaceProjects.Validate()
+	if err != nil {
+		return err
+	}
+
+	if conn.NamespaceProjects.Enabled && isDedicatedProject(conn.Project) {
+		return fmt.Errorf("%w: namespace projects can't be combined with a dedicated project", ErrUsage)
+	}
+
+	return nil
+}
+
+func newClient(conn ConnectionConfig, config *config.Config) *client {
+	cl := &client{
+		config:       config,
+		conn:         conn,
+		projectIndex: newProjectIndex(),
+		locks:        newObjectLocks(),
+		events:       newEventBus(eventWorkers, eventQueueSize),
+	}
+
+	if conn.Cache.Enabled {
+		cl.cache = newObjectCache()
+	}
+
+	return cl
+}
+
 // GetServer returns the lxd ContainerServer. TODO: since it created it and others want to access lxd too (lxdbridge
 // network plugin) either return it here, or extract creation of the connection outside and pass server into
 // NewClient(), but that makes the initialisation NewClient() pretty unnecessary
-func (l *client) GetServer() lxd.ContainerServer {
-	return l.server
+func (l *client) GetServer() lxd.InstanceServer {
+	return l.currentServer()
 }
 
 // SetEventHandler for container's starting and stopping events
 func (l *client) SetEventHandler(eh EventHandler) {
-	l.eventHandler = eh
+	l.Subscribe(l.eventHandlerSubscriber(eh))
+}
+
+// Subscribe to the lifecycle events of containers and return the function to unsubscribe again
+func (l *client) Subscribe(fn EventSubscriber) func() {
+	return l.events.subscribe(fn)
 }
 
 // SetCRITestMode enables the critest mode
@@ -127,14 +197,35 @@ func (l *client) SetCRITestMode() {
 	}
 }
 
+// SetImageRewriteRules sets the rules to rewrite requested images when pulling
+func (l *client) SetImageRewriteRules(rules ImageRewriteRules) error {
+	err := rules.Validate()
+	if err != nil {
+		return err
+	}
+
+	l.rewriteRules = rules
+
+	return nil
+}
+
+// imageRewriteRules returns the configured rules, followed by the critest rules if enabled
+func (l *client) imageRewriteRules() ImageRewriteRules {
+	if l.critestMode {
+		return append(append(ImageRewriteRules{}, l.rewriteRules...), critestRewriteRules(l.config.DefaultRemote)...)
+	}
+
+	return l.rewriteRules
+}
+
 type RuntimeInfo struct {
 	// API version of the container runtime. The string must be semver-compatible.
 	Version string
 }
 
 // GetRuntimeInfo returns informations about the runtime
-func (l *client) GetRuntimeInfo() (*RuntimeInfo, error) {
-	server, _, err := l.server.GetServer()
+func (l *client) GetRuntimeInfo(ctx context.Context) (*RuntimeInfo, error) {
+	server, _, err := l.currentServer().GetServer()
 	if err != nil {
 		return nil, err
 	}
@@ -144,144 +235,3 @@ func (l *client) GetRuntimeInfo() (*RuntimeInfo, error) {
 		Version: fmt.Sprintf("%s.0", server.APIVersion),
 	}, nil
 }
-
-func (l *client) connect() error {
-	args := lxd.ConnectionArgs{
-		HTTPClient: &http.Client{
-			// this was a byproduct of a bughunt, but i figured using TCP connections with TLS instead of unix sockets
-			// might by useful some time in the future so i will leave this here commented out
-			// to setup this up in LXD see: https://help.ubuntu.com/lts/serverguide/lxd.html.en#lxd-server-config
-
-			// tlsServerCert, err := io.ReadFile("/root/.config/lxc/servercerts/r1.crt")
-			// if err != nil {
-			// 	panic(err)
-			// }
-			// tlsClientCert, err := io.ReadFile("/root/.config/lxc/client.crt")
-			// if err != nil {
-			// 	panic(err)
-			// }
-			// tlsClientKey, err := io.ReadFile("/root/.config/lxc/client.key")
-			// if err != nil {
-			// 	panic(err)
-			// }
-
-			// server, err := lxd.ConnectLXD("https://127.0.0.1:8443", &lxd.ConnectionArgs{
-			// 	TLSServerCert:      string(tlsServerCert),
-			// 	TLSClientCert:      string(tlsClientCert),
-			// 	TLSClientKey:       string(tlsClientKey),
-			// 	InsecureSkipVerify: true,
-			// })
-			// -------------------------------------------
-			// it was discovered when using a container with "hostnetwork: true" LXE
-			// would leak filehandles indefinitely until the process hits the system limit and
-			// LXE would stop working since no new connections could be opened.
-			// This happens for unix sockets as well as for tcp/tls connections.
-
-			// this issue could be observed by
-			// a) lsof -n -p $(pidof lxe)     yielding more and more connections
-			// b) pkill -SIGABRT lxe          seeing many many goroutines like this:
-			// net/http.(*persistConn).readLoop(0xc000273b00)
-			// 	/home/dj/src/go/src/net/http/transport.go:1761 +0x6b9
-			// and
-			// net/http.(*persistConn).writeLoop(0xc0002c25a0)
-			// 	/home/dj/src/go/src/net/http/transport.go:1885 +0x113
-			// without any stacktrace/callstack.
-
-			// online search will lead to some golang issues at github which most of are marked as fixed
-			// as well as the solution to "defer resp.Body.Close()" which is done by the LXD client api.
-			// other measures like "_, err = io.Copy(io.Discard, resp.Body)" were tried as well.
-			// (see https://hackernoon.com/avoiding-memory-leak-in-golang-api-1843ef45fca8 e.g.)
-
-			// the chain to track this is:
-			//    call lxd.ConnectLXDUnix (lxf/client.go)
-			// -> unixHttpClient (lxd/client/connection.go)
-			//    >> here we force the httpClient to have a Timeout <<
-			// -> unixHttpClient (lxd/client/util.go) setups a DialUnix inside of a Transport inside of the HttpClient
-
-			// the HttpClient tries to reuse already opened connections (this is done by golangs core library
-			// and is rather transparent for the caller) which does not seem to happen in this special case.
-			// this commit forces a timeout on the httpClient used by the LXE (via the LXD client API) to talk to LXD.
-
-			// this does not fix the real problem, which can be either in LXE, LXD client API or the LXD server
-			// and still needs more investigation.
-			// since sharing the networknamespace between host and container via "lxc.raw = lxc.net.0.type=none"
-			// is neither officially supported nor encouraged, filing a bugreport against LXD is rather pointless.
-			Timeout: lxdHTTPTimeout,
-		},
-	}
-
-	server, err := lxd.ConnectLXDUnix(l.socket, &args)
-	if err != nil {
-		return err
-	}
-
-	// register LXD eventhandler
-	listener, err := server.GetEvents()
-	if err != nil {
-		return err
-	}
-
-	_, err = listener.AddHandler([]string{"lifecycle"}, l.lifecycleEventHandler)
-	if err != nil {
-		return err
-	}
-
-	l.server = server
-	l.opwait = lxo.NewClient(server)
-
-	return nil
-}
-
-// Detect if server needs to be connected again to. Seems to be needed if we get a lxd.RemoteOperation (e.g. in CopyImage), the op.Wait() never succeeds unless we have connected to the lxd socket again. All other lxd.Operations seem to work fine and this wouldn't be needed for them.
-func (l *client) detectNeedReconnect() { // nolint: gocognit, cyclop
-	// currently I know no way to find out when a socket is gone as all is encapsulated in lxd.ContainerServer. We can set an fsnotify to the socket file so we get an event when it was created. If we got such event, we try to connect again until it is successful.
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		log.Fatal(err.Error())
-	}
-	defer watcher.Close()
-
-	err = watcher.Add(path.Dir(l.socket))
-	if err != nil {
-		log.Fatal(err.Error()) // nolint: gocritic
-	}
-
-	log := log.WithField("lxdsocket", l.socket)
-
-	for {
-		select {
-		case event, ok := <-watcher.Events:
-			if !ok {
-				return
-			}
-
-			if event.Op&fsnotify.Create == fsnotify.Create && event.Name == l.socket {
-				log.Info("lxd socket got created, trying to reconnect")
-
-				go func() {
-					for {
-						err := l.connect()
-						if err != nil {
-							// print error and try again
-							log.WithError(err).Error("failed reconnecting to lxd socket")
-						} else {
-							log.Info("reconnected to lxd socket")
-
-							return
-						}
-					}
-				}()
-			}
-
-			if event.Op&fsnotify.Remove == fsnotify.Remove && event.Name == l.socket {
-				log.Warn("lxd socket got deleted, will try to reconnect once it's created again")
-			}
-		case err, ok := <-watcher.Errors:
-			if !ok {
-				return
-			}
-
-			log.WithError(err).Fatal("watcher error")
-		}
-	}
-}
@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -11,13 +12,16 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func testClient() (*client, *lxdfakes.FakeContainerServer) {
-	fake := &lxdfakes.FakeContainerServer{}
+func testClient() (*client, *lxdfakes.FakeInstanceServer) {
+	fake := &lxdfakes.FakeInstanceServer{}
 
 	return &client{
-		server: fake,
-		config: &config.Config{},
-		opwait: lxo.NewClient(fake),
+		server:       fake,
+		config:       &config.Config{},
+		opwait:       lxo.NewClient(fake, lxo.Timeouts{}),
+		projectIndex: newProjectIndex(),
+		locks:        newObjectLocks(),
+		events:       newEventBus(1, 1),
 	}, fake
 }
 
@@ -31,7 +35,7 @@ func TestClient_GetRuntimeInfo_Ok(t *testing.T) {
 		},
 	}, "", nil)
 
-	info, err := client.GetRuntimeInfo()
+	info, err := client.GetRuntimeInfo(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, 1, fake.GetServerCallCount())
 	assert.Exactly(t, "a.b.0", info.Version)
@@ -43,7 +47,7 @@ func TestClient_GetRuntimeInfo_Error(t *testing.T) {
 	client, fake := testClient()
 	fake.GetServerReturns(nil, "", errors.New("some connection error"))
 
-	_, err := client.GetRuntimeInfo()
+	_, err := client.GetRuntimeInfo(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, 1, fake.GetServerCallCount())
 }
@@ -0,0 +1,339 @@
+package lxf
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/automaticserver/lxe/lxf/lxo"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrUnknownRemote = errors.New("unknown remote")
+
+	connectionCheckInterval = 10 * time.Second
+	reconnectMinBackoff     = 1 * time.Second
+	reconnectMaxBackoff     = 30 * time.Second
+)
+
+// ConnectionConfig describes how to connect to LXD. If neither Remote nor URL is set, the local Socket is used.
+type ConnectionConfig struct {
+	// Socket of the local LXD
+	Socket string
+	// Remote is the name of a remote in the lxd remote config to connect to over https. The client certificate and
+	// key of the remote config and the stored server certificate of that remote are used.
+	Remote string
+	// URL of the https endpoint of LXD, overrides the address of Remote
+	URL string
+	// TLSClientCert is the path to the client certificate, overrides the one of the remote config
+	TLSClientCert string
+	// TLSClientKey is the path to the client key, overrides the one of the remote config
+	TLSClientKey string
+	// TLSCA is the path to the CA certificate to validate the server certificate against
+	TLSCA string
+	// Project all objects are managed in. It is created if it doesn't exist.
+	Project string
+	// Profiles the containers use besides the default profile, they are copied from the default project into the
+	// dedicated project and the namespace projects
+	Profiles []string
+	// NamespaceProjects places the sandboxes and containers of each kubernetes namespace in its own project
+	NamespaceProjects NamespaceProjects
+	// Cache keeps the sandboxes and containers in memory, updated by the events of this connection
+	Cache CacheConfig
+	// OperationTimeouts limit how long is waited for the operations of LXD
+	OperationTimeouts OperationTimeouts
+}
+
+// OperationTimeouts limit how long is waited for the operations of LXD, 0 waits without limit
+type OperationTimeouts = lxo.Timeouts
+
+// isRemote reports whether LXD is reached over https
+func (c ConnectionConfig) isRemote() bool {
+	return c.Remote != "" || c.URL != ""
+}
+
+// endpoint returns a description of where LXD is reached under for logging
+func (c ConnectionConfig) endpoint() string {
+	switch {
+	case c.URL != "":
+		return c.URL
+	case c.Remote != "":
+		return c.Remote
+	default:
+		return c.Socket
+	}
+}
+
+func (l *client) connect() error {
+	server, err := l.connectServer()
+	if err != nil {
+		return err
+	}
+
+	if isDedicatedProject(l.conn.Project) {
+		err = ensureProject(server, l.conn.Project, projectFeatures, l.conn.Profiles)
+		if err != nil {
+			return err
+		}
+
+		// all calls and events are scoped to the project from now on
+		server = server.UseProject(l.conn.Project)
+	}
+
+	// register LXD eventhandler, with namespace projects the events of all projects are needed
+	var listener *lxd.EventListener
+	if l.conn.NamespaceProjects.Enabled {
+		listener, err = server.GetEventsAllProjects()
+	} else {
+		listener, err = server.GetEvents()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = listener.AddHandler([]string{"lifecycle"}, l.lifecycleEventHandler)
+	if err != nil {
+		return err
+	}
+
+	l.useServer(server)
+
+	// events might have been missed while disconnected
+	err = l.resyncCache()
+	if err != nil {
+		listener.Disconnect()
+
+		return err
+	}
+
+	go l.watchConnection(server, listener)
+
+	return nil
+}
+
+// useServer replaces the server of the connection, requests already running finish with the previous one
+func (l *client) useServer(server lxd.InstanceServer) {
+	opwait := lxo.NewClient(server, l.conn.OperationTimeouts)
+
+	l.connMu.Lock()
+	defer l.connMu.Unlock()
+
+	l.server = server
+	l.opwait = opwait
+}
+
+// currentServer returns the server of the current connection
+func (l *client) currentServer() lxd.InstanceServer { // nolint: ireturn
+	l.connMu.RLock()
+	defer l.connMu.RUnlock()
+
+	return l.server
+}
+
+// currentOpwait returns the operation helper of the current connection
+func (l *client) currentOpwait() *lxo.LXO {
+	l.connMu.RLock()
+	defer l.connMu.RUnlock()
+
+	return l.opwait
+}
+
+func (l *client) connectServer() (lxd.InstanceServer, error) { // nolint: ireturn
+	args := &lxd.ConnectionArgs{
+		HTTPClient: &http.Client{
+			// it was discovered when using a container with "hostnetwork: true" LXE
+			// would leak filehandles indefinitely until the process hits the system limit and
+			// LXE would stop working since no new connections could be opened.
+			// This happens for unix sockets as well as for tcp/tls connections.
+
+			// this issue could be observed by
+			// a) lsof -n -p $(pidof lxe)     yielding more and more connections
+			// b) pkill -SIGABRT lxe          seeing many many goroutines like this:
+			// net/http.(*persistConn).readLoop(0xc000273b00)
+			// 	/home/dj/src/go/src/net/http/transport.go:1761 +0x6b9
+			// and
+			// net/http.(*persistConn).writeLoop(0xc0002c25a0)
+			// 	/home/dj/src/go/src/net/http/transport.go:1885 +0x113
+			// without any stacktrace/callstack.
+
+			// online search will lead to some golang issues at github which most of are marked as fixed
+			// as well as the solution to "defer resp.Body.Close()" which is done by the LXD client api.
+			// other measures like "_, err = io.Copy(io.Discard, resp.Body)" were tried as well.
+			// (see https://hackernoon.com/avoiding-memory-leak-in-golang-api-1843ef45fca8 e.g.)
+
+			// the chain to track this is:
+			//    call lxd.ConnectLXDUnix (lxf/connection.go)
+			// -> unixHttpClient (lxd/client/connection.go)
+			//    >> here we force the httpClient to have a Timeout <<
+			// -> unixHttpClient (lxd/client/util.go) setups a DialUnix inside of a Transport inside of the HttpClient
+
+			// the HttpClient tries to reuse already opened connections (this is done by golangs core library
+			// and is rather transparent for the caller) which does not seem to happen in this special case.
+			// this commit forces a timeout on the httpClient used by the LXE (via the LXD client API) to talk to LXD.
+
+			// this does not fix the real problem, which can be either in LXE, LXD client API or the LXD server
+			// and still needs more investigation.
+			// since sharing the networknamespace between host and container via "lxc.raw = lxc.net.0.type=none"
+			// is neither officially supported nor encouraged, filing a bugreport against LXD is rather pointless.
+			Timeout: lxdHTTPTimeout,
+		},
+	}
+
+	if !l.conn.isRemote() {
+		return lxd.ConnectLXDUnix(l.conn.Socket, args)
+	}
+
+	addr, err := l.remoteConnectionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return lxd.ConnectLXD(addr, args)
+}
+
+// remoteConnectionArgs fills the TLS settings into args and returns the address to connect to
+func (l *client) remoteConnectionArgs(args *lxd.ConnectionArgs) (string, error) {
+	addr := l.conn.URL
+	clientCert := l.conn.TLSClientCert
+	clientKey := l.conn.TLSClientKey
+
+	if l.conn.Remote != "" {
+		remote, has := l.config.Remotes[l.conn.Remote]
+		if !has {
+			return "", fmt.Errorf("%w: %s", ErrUnknownRemote, l.conn.Remote)
+		}
+
+		if addr == "" {
+			addr = remote.Addr
+		}
+
+		if clientCert == "" {
+			clientCert = l.config.ConfigPath("client.crt")
+		}
+
+		if clientKey == "" {
+			clientKey = l.config.ConfigPath("client.key")
+		}
+
+		err := readFileIfExists(l.config.ServerCertPath(l.conn.Remote), &args.TLSServerCert)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if !strings.HasPrefix(addr, "https://") {
+		return "", fmt.Errorf("%w: only https is supported to connect to a remote lxd: %s", ErrUsage, addr)
+	}
+
+	err := readFile(clientCert, &args.TLSClientCert)
+	if err != nil {
+		return "", err
+	}
+
+	err = readFile(clientKey, &args.TLSClientKey)
+	if err != nil {
+		return "", err
+	}
+
+	if l.conn.TLSCA != "" {
+		err = readFile(l.conn.TLSCA, &args.TLSCA)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return addr, nil
+}
+
+func readFile(path string, into *string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	*into = string(b)
+
+	return nil
+}
+
+func readFileIfExists(path string, into *string) error {
+	err := readFile(path, into)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// watchConnection waits until the event stream of the server disconnects or a connection error occurs and then
+// reconnects. A lost connection is only detected by these, as the lxd client does not expose the state of the
+// connection. Also a lxd.RemoteOperation (e.g. in CopyImage) never succeeds on a connection which was interrupted.
+func (l *client) watchConnection(server lxd.InstanceServer, listener *lxd.EventListener) {
+	log := log.WithField("lxd", l.conn.endpoint())
+
+	disconnected := make(chan error, 1)
+
+	go func() {
+		disconnected <- listener.Wait()
+	}()
+
+	ticker := time.NewTicker(connectionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-disconnected:
+			log.WithError(err).Warn("lxd event stream disconnected, trying to reconnect")
+		case <-ticker.C:
+			_, _, err := server.GetServer()
+			if err == nil || !isConnectionError(err) {
+				continue
+			}
+
+			log.WithError(err).Warn("lost connection to lxd, trying to reconnect")
+			listener.Disconnect()
+		}
+
+		l.reconnect()
+
+		return
+	}
+}
+
+// reconnect tries to connect until it is successful, waiting increasingly longer between the attempts
+func (l *client) reconnect() {
+	log := log.WithField("lxd", l.conn.endpoint())
+	backoff := reconnectMinBackoff
+
+	for {
+		err := l.connect()
+		if err == nil {
+			log.Info("reconnected to lxd")
+
+			return
+		}
+
+		log.WithError(err).WithFields(logrus.Fields{"retry": backoff}).Error("failed reconnecting to lxd")
+
+		time.Sleep(backoff)
+
+		backoff *= 2
+		if backoff > reconnectMaxBackoff {
+			backoff = reconnectMaxBackoff
+		}
+	}
+}
+
+// isConnectionError reports whether the error was caused by the connection instead of the response of lxd
+func isConnectionError(err error) bool {
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
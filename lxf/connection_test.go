@@ -0,0 +1,148 @@
+package lxf
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/lxc/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRemoteConfig(t *testing.T) *config.Config {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for file, content := range map[string]string{
+		"client.crt":            "remoteconfigcert",
+		"client.key":            "remoteconfigkey",
+		"servercerts/lxd01.crt": "servercert",
+		"explicit.crt":          "explicitcert",
+		"ca.crt":                "ca",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content), 0600))
+	}
+
+	return &config.Config{
+		ConfigDir: dir,
+		Remotes: map[string]config.Remote{
+			"lxd01":  {Addr: "https://lxd01:8443"},
+			"images": {Addr: "https://images.linuxcontainers.org", Protocol: "simplestreams", Public: true},
+			"local":  {Addr: "unix://"},
+		},
+	}
+}
+
+func TestClient_remoteConnectionArgs_Remote(t *testing.T) {
+	t.Parallel()
+
+	c, _ := testClient()
+	c.config = testRemoteConfig(t)
+	c.conn = ConnectionConfig{Remote: "lxd01"}
+
+	args := &lxd.ConnectionArgs{}
+	addr, err := c.remoteConnectionArgs(args)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://lxd01:8443", addr)
+	assert.Equal(t, "remoteconfigcert", args.TLSClientCert)
+	assert.Equal(t, "remoteconfigkey", args.TLSClientKey)
+	assert.Equal(t, "servercert", args.TLSServerCert)
+	assert.Empty(t, args.TLSCA)
+}
+
+func TestClient_remoteConnectionArgs_Explicit(t *testing.T) {
+	t.Parallel()
+
+	c, _ := testClient()
+	c.config = testRemoteConfig(t)
+	c.conn = ConnectionConfig{
+		URL:           "https://10.0.0.1:8443",
+		TLSClientCert: c.config.ConfigPath("explicit.crt"),
+		TLSClientKey:  c.config.ConfigPath("client.key"),
+		TLSCA:         c.config.ConfigPath("ca.crt"),
+	}
+
+	args := &lxd.ConnectionArgs{}
+	addr, err := c.remoteConnectionArgs(args)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1:8443", addr)
+	assert.Equal(t, "explicitcert", args.TLSClientCert)
+	assert.Equal(t, "remoteconfigkey", args.TLSClientKey)
+	assert.Equal(t, "ca", args.TLSCA)
+	assert.Empty(t, args.TLSServerCert)
+}
+
+func TestClient_remoteConnectionArgs_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		conn ConnectionConfig
+		err  error
+	}{
+		{"unknownremote", ConnectionConfig{Remote: "lxd02"}, ErrUnknownRemote},
+		{"unixremote", ConnectionConfig{Remote: "local"}, ErrUsage},
+		{"missingcert", ConnectionConfig{URL: "https://10.0.0.1:8443", TLSClientCert: "/nonexistent"}, os.ErrNotExist},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _ := testClient()
+			c.config = testRemoteConfig(t)
+			c.conn = tt.conn
+
+			_, err := c.remoteConnectionArgs(&lxd.ConnectionArgs{})
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func Test_isConnectionError(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isConnectionError(&net.OpError{Op: "dial", Err: os.ErrNotExist}))
+	assert.False(t, isConnectionError(ErrNotFound))
+}
+
+// Run with -race, the server is replaced on reconnect while requests use it
+func TestClient_useServer_WhileRequestsRun(t *testing.T) {
+	t.Parallel()
+
+	c, _ := testClient()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				_, err := c.ListImages(context.Background(), "")
+				assert.NoError(t, err)
+
+				_ = c.currentOpwait()
+			}
+		}()
+	}
+
+	for i := 0; i < 100; i++ {
+		c.useServer(&lxdfakes.FakeInstanceServer{})
+	}
+
+	wg.Wait()
+}
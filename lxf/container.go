@@ -1,7 +1,9 @@
 package lxf
 
 import (
+	"context"
 	"crypto/md5" // nolint: gosec
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/lxc/lxd/shared/api"
 	opencontainers "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/uuid"
 )
 
@@ -43,6 +46,13 @@ var (
 			cfgCloudInitMetaData,
 			cfgCloudInitNetworkConfig,
 			cfgVolatileBaseImage,
+			cfgJob,
+			cfgCommand,
+			cfgWorkingDir,
+			cfgExitCode,
+			cfgStdin,
+			cfgTTY,
+			cfgJobOperation,
 		}, reservedConfigCRI...,
 		)...,
 	).WithReservedPrefixes(
@@ -54,6 +64,11 @@ var (
 	)
 )
 
+// IsReservedContainerConfig reports whether the config key is managed by LXE and can't be set on a container
+func IsReservedContainerConfig(key string) bool {
+	return containerConfigStore.IsReserved(key)
+}
+
 // Container represents a LXD container including CRI specific configuration
 type Container struct {
 	// LXDObject inherits common CRI fields
@@ -67,6 +82,21 @@ type Container struct {
 	Privileged bool
 	// Environment specifies to the container exported environment variables
 	Environment map[string]string
+	// Type of the instance the container is run as. Can't be changed once the container is created.
+	Type InstanceType
+	// Job containers run Command to completion, the instance is stopped when it exits. Without a command the job ends
+	// when the instance stops itself.
+	Job bool
+	// Command with its arguments run by a job container
+	Command []string
+	// WorkingDir of the command
+	WorkingDir string
+	// Interactive jobs don't run their command when started, it's run when attached to. Used for debug containers.
+	Interactive bool
+	// TTY is allocated for the command of an interactive job
+	TTY bool
+	// JobOperation is the id of the LXD operation executing the command of a running job
+	JobOperation string
 
 	// CRIObject inherits common CRI fields
 	CRIObject
@@ -78,6 +108,8 @@ type Container struct {
 	FinishedAt time.Time
 	// StateName of the current container
 	StateName ContainerStateName
+	// ExitCode of the command of a job container
+	ExitCode int32
 	// LogPath TODO, to be implemented?
 	LogPath string
 	// CloudInit fields
@@ -103,9 +135,19 @@ type ContainerState struct {
 	Stats ContainerStats
 	// Network represents the network information section of a LXD container's state
 	// +readonly
-	Network map[string]api.ContainerStateNetwork
+	Network map[string]api.InstanceStateNetwork
 }
 
+// InstanceType is the type of LXD instance a container is run as
+type InstanceType string
+
+const (
+	// InstanceTypeContainer runs the container as a system container
+	InstanceTypeContainer InstanceType = "container"
+	// InstanceTypeVirtualMachine runs the container as a virtual machine
+	InstanceTypeVirtualMachine InstanceType = "virtual-machine"
+)
+
 // ContainerStateName represents the state name of the container
 type ContainerStateName string
 
@@ -140,10 +182,10 @@ type ContainerMetadata struct {
 // Sandbox looks up the parent sandbox
 // Implemented as lazy loading, and returns same result if already looked up
 // Not thread safe! But it's expected the pointers stay in the same routine
-func (c *Container) Sandbox() (*Sandbox, error) {
+func (c *Container) Sandbox(ctx context.Context) (*Sandbox, error) {
 	var err error
 	if c.sandbox == nil {
-		c.sandbox, err = c.getSandbox()
+		c.sandbox, err = c.getSandbox(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -157,9 +199,9 @@ func (c *Container) SandboxID() string {
 	return c.Profiles[len(c.Profiles)-1]
 }
 
-func (c *Container) getSandbox() (*Sandbox, error) {
+func (c *Container) getSandbox(ctx context.Context) (*Sandbox, error) {
 	if len(c.Profiles) > 0 {
-		sandbox, err := c.client.GetSandbox(c.SandboxID())
+		sandbox, err := c.client.GetSandbox(ctx, c.SandboxID())
 		if err != nil {
 			return nil, err
 		}
@@ -173,10 +215,10 @@ func (c *Container) getSandbox() (*Sandbox, error) {
 // State looks up additional state info
 // Implemented as lazy loading, and returns same result if already looked up
 // Not thread safe! But it's expected the pointers stay in the same routine
-func (c *Container) State() (*ContainerState, error) {
+func (c *Container) State(ctx context.Context) (*ContainerState, error) {
 	var err error
 	if c.state == nil {
-		c.state, err = c.getState()
+		c.state, err = c.getState(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -185,10 +227,10 @@ func (c *Container) State() (*ContainerState, error) {
 	return c.state, nil
 }
 
-func (c *Container) getState() (*ContainerState, error) {
+func (c *Container) getState(ctx context.Context) (*ContainerState, error) {
 	cs := &ContainerState{}
 
-	state, _, err := c.client.server.GetContainerState(c.ID)
+	state, _, err := c.client.projectServer(c.project).GetInstanceState(c.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -205,8 +247,8 @@ func (c *Container) getState() (*ContainerState, error) {
 }
 
 // refresh loads the container again from LXD with data and ETag
-func (c *Container) refresh() error {
-	r, err := c.client.GetContainer(c.ID)
+func (c *Container) refresh(ctx context.Context) error {
+	r, err := c.client.GetContainer(ctx, c.ID)
 	if err != nil {
 		return err
 	}
@@ -216,74 +258,140 @@ func (c *Container) refresh() error {
 	return nil
 }
 
-// Apply will save the changes of a container if validation was successful, refreshes ETag after save
-func (c *Container) Apply() error {
-	err := c.validate()
+// Apply will save the changes of a container if validation was successful, refreshes ETag after save. Transient
+// errors of LXD are retried for existing containers, but an outdated ETag is not, use Update for that.
+func (c *Container) Apply(ctx context.Context) error {
+	err := c.validate(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = c.apply()
+	if c.ID == "" {
+		err = c.apply(ctx)
+		if err != nil {
+			return err
+		}
+
+		return c.refresh(ctx)
+	}
+
+	unlock := c.client.locks.lock(c.ID)
+	defer unlock()
+
+	err = retry(ctx, c.log(ctx), isTransientError, func() error {
+		return c.apply(ctx)
+	})
 	if err != nil {
 		return err
 	}
 
-	return c.refresh()
+	return c.refresh(ctx)
+}
+
+// Update loads the container again, changes it with mutate and saves it. If the container was changed in the
+// meantime or LXD had a transient error, this is repeated. Updates of the same container are serialized.
+func (c *Container) Update(ctx context.Context, mutate func(*Container) error) error {
+	unlock := c.client.locks.lock(c.ID)
+	defer unlock()
+
+	return retry(ctx, c.log(ctx), isRetryableUpdateError, func() error {
+		err := c.refresh(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = mutate(c)
+		if err != nil {
+			return err
+		}
+
+		err = c.validate(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = c.apply(ctx)
+		if err != nil {
+			return err
+		}
+
+		return c.refresh(ctx)
+	})
+}
+
+func (c *Container) log(ctx context.Context) *logrus.Entry {
+	return log.WithContext(ctx).WithField("containerid", c.ID)
 }
 
-// Start the container
-func (c *Container) Start() error {
-	err := c.client.opwait.StartContainer(c.ID)
+// Start the container. The command of a job container is run in the background, the one of an interactive job when
+// attached to.
+func (c *Container) Start(ctx context.Context) error {
+	err := c.client.projectOpwait(c.project).StartInstance(ctx, c.ID)
 	if err != nil {
 		return err
 	}
 
 	// when changing state of container, need to refresh ETag
-	err = c.refresh()
+	c.client.cache.invalidateContainer(c.project, c.ID)
+
+	err = c.Update(ctx, func(c *Container) error {
+		// delete created mark if exists, so next stopping state can be exited
+		delete(c.Config, cfgState)
+		c.StartedAt = time.Now()
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	// delete created mark if exists, so next stopping state can be exited
-	delete(c.Config, cfgState)
-	c.StartedAt = time.Now()
+	if c.Job && len(c.Command) > 0 && !c.Interactive {
+		job := *c
+		go job.runJob(context.Background())
+	}
 
-	return c.Apply()
+	return nil
 }
 
 // Stop will try to stop the container, returns nil when container is already stopped or
 // got stopped in the meantime, otherwise it will return an error.
-func (c *Container) Stop(timeout int) error {
-	err := c.client.opwait.StopContainer(c.ID, timeout, 1)
+func (c *Container) Stop(ctx context.Context, timeout int) error {
+	err := c.client.projectOpwait(c.project).StopInstance(ctx, c.ID, timeout, 1)
 	if err != nil {
 		return err
 	}
 
 	// when changing state of container, need to refresh ETag
-	err = c.refresh()
-	if err != nil {
-		return err
-	}
+	c.client.cache.invalidateContainer(c.project, c.ID)
 
-	c.FinishedAt = time.Now()
+	return c.Update(ctx, func(c *Container) error {
+		if c.Job {
+			c.finishJob(CodeJobTerminated)
+		} else {
+			c.FinishedAt = time.Now()
+		}
 
-	return c.Apply()
+		return nil
+	})
 }
 
 // Delete the container, returns nil when container is already deleted or
 // got deleted in the meantime, otherwise it will return an error.
-func (c *Container) Delete() error {
-	err := c.client.opwait.DeleteContainer(c.ID)
+func (c *Container) Delete(ctx context.Context) error {
+	err := c.client.projectOpwait(c.project).DeleteInstance(ctx, c.ID)
 	if err != nil {
 		return err
 	}
 
+	c.client.projectIndex.delete(c.ID)
+	c.client.cache.removeContainer(c.project, c.ID)
+
 	return nil
 }
 
 // validate checks for misconfigurations
-func (c *Container) validate() error {
-	s, err := c.Sandbox()
+func (c *Container) validate(ctx context.Context) error {
+	s, err := c.Sandbox(ctx)
 	if err != nil {
 		return err
 	}
@@ -300,35 +408,53 @@ func (c *Container) validate() error {
 
 // apply saves the changes to LXD
 // Will not obtain the new ETag!
-func (c *Container) apply() error {
+func (c *Container) apply(ctx context.Context) error {
 	config := makeContainerConfig(c)
 	devices := makeContainerDevices(c)
 
-	contPut := api.ContainerPut{
+	contPut := api.InstancePut{
 		Profiles: c.Profiles,
 		Config:   config,
 		Devices:  devices,
 	}
 
 	if c.ID == "" {
-		// container has to be created
+		// container has to be created in the project of its sandbox
+		s, err := c.Sandbox(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.project = s.project
 		c.ID = c.CreateID()
 
-		return c.client.opwait.CreateContainer(api.ContainersPost{
-			Name:         c.ID,
-			ContainerPut: contPut,
-			Source: api.ContainerSource{
+		err = c.client.projectOpwait(c.project).CreateInstance(ctx, api.InstancesPost{
+			Name:        c.ID,
+			Type:        api.InstanceType(c.Type),
+			InstancePut: contPut,
+			Source: api.InstanceSource{
 				Fingerprint: c.Image,
 				Type:        "image",
 			},
 		})
+		if err != nil {
+			return err
+		}
+
+		c.client.indexObject(c.ID, c.project)
+
+		return nil
 	}
 	// else container has to be updated
 	if c.ETag == "" {
 		return fmt.Errorf("update container not allowed: %w", ErrMissingETag)
 	}
 
-	err := c.client.opwait.UpdateContainer(c.ID, contPut, c.ETag)
+	err := c.client.projectOpwait(c.project).UpdateInstance(ctx, c.ID, contPut, c.ETag)
+
+	// the cached etag is outdated now, or already was if the update failed
+	c.client.cache.invalidateContainer(c.project, c.ID)
+
 	if err != nil {
 		return err
 	}
@@ -345,8 +471,8 @@ func (c *Container) CreateID() string {
 
 // GetInetAddress returns the IPv4 address of the first matching interface in the parameter list
 // empty string if nothing was found
-func (c *Container) GetInetAddress(ifs []string) string {
-	st, err := c.State()
+func (c *Container) GetInetAddress(ctx context.Context, ifs []string) string {
+	st, err := c.State(ctx)
 	if err != nil {
 		return ""
 	}
@@ -393,13 +519,40 @@ func makeContainerConfig(c *Container) map[string]string { // nolint: gocognit,
 	config[cfgCreatedAt] = strconv.FormatInt(c.CreatedAt.UnixNano(), 10)
 	config[cfgStartedAt] = strconv.FormatInt(c.StartedAt.UnixNano(), 10)
 	config[cfgFinishedAt] = strconv.FormatInt(c.FinishedAt.UnixNano(), 10)
-	config[cfgSecurityPrivileged] = strconv.FormatBool(c.Privileged)
+	// virtual machines are isolated by the hypervisor, LXD doesn't know this key for them
+	if c.Type != InstanceTypeVirtualMachine {
+		config[cfgSecurityPrivileged] = strconv.FormatBool(c.Privileged)
+	}
 	config[cfgLogPath] = c.LogPath
 	config[cfgIsCRI] = strconv.FormatBool(true)
 	config[cfgMetaName] = c.Metadata.Name
 	config[cfgMetaAttempt] = strconv.FormatUint(uint64(c.Metadata.Attempt), 10)
 	config[cfgVolatileBaseImage] = c.Image
 
+	if c.Job {
+		config[cfgJob] = strconv.FormatBool(true)
+		config[cfgExitCode] = strconv.FormatInt(int64(c.ExitCode), 10)
+
+		if len(c.Command) > 0 {
+			// the command can't fail to be encoded
+			cmd, _ := json.Marshal(c.Command) // nolint: errchkjson
+			config[cfgCommand] = string(cmd)
+		}
+
+		if c.WorkingDir != "" {
+			config[cfgWorkingDir] = c.WorkingDir
+		}
+
+		if c.JobOperation != "" {
+			config[cfgJobOperation] = c.JobOperation
+		}
+
+		if c.Interactive {
+			config[cfgStdin] = strconv.FormatBool(true)
+			config[cfgTTY] = strconv.FormatBool(c.TTY)
+		}
+	}
+
 	for k, v := range c.Environment {
 		config[cfgEnvironmentPrefix+"."+k] = v
 	}
@@ -33,3 +33,19 @@ func Test_makeContainerConfig_KeepVolatile(t *testing.T) {
 
 	assert.Exactly(t, exp, act)
 }
+
+func Test_makeContainerConfig_VirtualMachine(t *testing.T) {
+	t.Parallel()
+
+	c := &Container{}
+	c.Config = map[string]string{}
+	c.Privileged = true
+
+	config := makeContainerConfig(c)
+	assert.Equal(t, "true", config[cfgSecurityPrivileged])
+
+	c.Type = InstanceTypeVirtualMachine
+
+	config = makeContainerConfig(c)
+	assert.NotContains(t, config, cfgSecurityPrivileged)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func getCRIContainer(cri string) api.Container {
+func getCRIContainer(cri string) api.Instance {
 	c := getSchemaContainer(SchemaVersionContainer)
 	if cri != "" {
 		c.Config[cfgIsCRI] = cri
@@ -118,7 +118,7 @@ func TestIsCRIPointer(t *testing.T) {
 	assert.Equal(t, true, i)
 }
 
-func satisfyContainerCri(ct *api.Container) *api.Container {
+func satisfyContainerCri(ct *api.Instance) *api.Instance {
 	ct.Config[cfgIsCRI] = "true"
 
 	return ct
@@ -2,6 +2,7 @@ package lxf
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -15,9 +16,12 @@ import (
 
 var (
 	critestDefaultImageSource = "images:alpine/edge/cloud"
-	critestOtherImageSources  = map[string]string{
-		"gcr.io:k8s-staging-cri-tools/test-image-2": "images:alpine/edge/cloud/arm64",
-		"gcr.io:k8s-staging-cri-tools/test-image-3": "images:alpine/edge/cloud/armhf",
+	// critest only refers to OCI images, so replace them all. Some tests expect distinct images, provide them using the
+	// variants of other architectures.
+	critestImageRewriteRules = ImageRewriteRules{
+		{Match: "gcr.io:k8s-staging-cri-tools/test-image-2", Targets: []string{critestDefaultImageSource}, Architecture: "aarch64"},
+		{Match: "gcr.io:k8s-staging-cri-tools/test-image-3", Targets: []string{critestDefaultImageSource}, Architecture: "armv7l"},
+		{Match: imageRewriteWildcard, Targets: []string{critestDefaultImageSource}},
 	}
 
 	critestDefaultAlias   = "critest/default"
@@ -38,23 +42,12 @@ func isCRITestImage(image api.Image) bool {
 	return false
 }
 
-// Replace requested image internally for critest purposes
-func replaceCRITestImage(image, defaultRemote string) string {
-	// don't manipulate locally referenced images
-	if strings.HasPrefix(image, fmt.Sprintf("%s:", defaultRemote)) {
-		return image
-	}
-
-	ret := critestDefaultImageSource
-
-	replacer, exists := critestOtherImageSources[image]
-	if exists {
-		ret = replacer
-	}
-
-	log.Infof("CRITest: replacing requested image '%s' with '%s'", image, ret)
+// critestRewriteRules returns the rules to replace requested images for critest purposes. Locally referenced images
+// are never manipulated.
+func critestRewriteRules(defaultRemote string) ImageRewriteRules {
+	local := fmt.Sprintf("%s:%s", defaultRemote, imageRewriteWildcard)
 
-	return ret
+	return append(ImageRewriteRules{{Match: local, Targets: []string{local}}}, critestImageRewriteRules...)
 }
 
 // Remove only lxe aliases for critest purposes since the provided base images for critesting are also local and we don't want to remove them
@@ -68,7 +61,7 @@ func (l *client) removeCRITestImage(image string) error {
 		if strings.HasPrefix(a.Name, lxeAliasPrefix) {
 			log.Infof("CRITest: Deleting alias '%s' for image fingerprint '%s'", a.Name, lxdImg.Fingerprint)
 
-			err = l.server.DeleteImageAlias(a.Name)
+			err = l.currentServer().DeleteImageAlias(a.Name)
 			if err != nil {
 				return err
 			}
@@ -79,8 +72,10 @@ func (l *client) removeCRITestImage(image string) error {
 }
 
 func (l *client) createCRITestImages() error { // nolint: gocognit, cyclop
+	ctx := context.Background()
+
 	// check whether the default image already exists
-	defaultFingerprint, err := getImageFingerprint(l.server, critestDefaultAlias)
+	defaultFingerprint, err := getImageFingerprint(l.currentServer(), critestDefaultAlias)
 	if err != nil && !IsNotFoundError(err) {
 		return err
 	}
@@ -89,7 +84,7 @@ func (l *client) createCRITestImages() error { // nolint: gocognit, cyclop
 	if IsNotFoundError(err) { // nolint: nestif
 		log.Info("downloading default image")
 
-		remote, alias, err := l.config.ParseRemote(critestDefaultImageSource)
+		remote, lxdImg, err := l.resolveImage(critestDefaultImageSource, "", InstanceTypeContainer)
 		if err != nil {
 			return err
 		}
@@ -99,12 +94,7 @@ func (l *client) createCRITestImages() error { // nolint: gocognit, cyclop
 			return err
 		}
 
-		lxdImg, err := getRemoteImageFromAliasOrFingerprint(imgServer, alias)
-		if err != nil {
-			return err
-		}
-
-		err = l.opwait.CopyImage(imgServer, *lxdImg, &lxd.ImageCopyArgs{CopyAliases: false})
+		err = l.currentOpwait().CopyImage(ctx, imgServer, *lxdImg, &lxd.ImageCopyArgs{CopyAliases: false})
 		if err != nil {
 			return err
 		}
@@ -120,7 +110,7 @@ func (l *client) createCRITestImages() error { // nolint: gocognit, cyclop
 	}
 
 	// check whether the webserver image already exists
-	_, err = getImageFingerprint(l.server, critestWebserverAlias)
+	_, err = getImageFingerprint(l.currentServer(), critestWebserverAlias)
 	if err != nil && !IsNotFoundError(err) {
 		return err
 	}
@@ -130,25 +120,25 @@ func (l *client) createCRITestImages() error { // nolint: gocognit, cyclop
 	// create the webserver image based on the default image, install nginx automatically with cloud-init and alias it accordingly
 	if IsNotFoundError(err) { // nolint: nestif
 		// in case of a previous error clean up
-		err = l.opwait.StopContainer(cName, criTestTimeout, 0)
+		err = l.currentOpwait().StopInstance(ctx, cName, criTestTimeout, 0)
 		if err != nil && !IsNotFoundError(err) {
 			return err
 		}
 
-		err = l.opwait.DeleteContainer(cName)
+		err = l.currentOpwait().DeleteInstance(ctx, cName)
 		if err != nil && !IsNotFoundError(err) {
 			return err
 		}
 
 		log.Infof("creating webserver image")
 
-		err = l.opwait.CreateContainer(api.ContainersPost{
+		err = l.currentOpwait().CreateInstance(ctx, api.InstancesPost{
 			Name: cName,
-			Source: api.ContainerSource{
+			Source: api.InstanceSource{
 				Fingerprint: defaultFingerprint,
 				Type:        "image",
 			},
-			ContainerPut: api.ContainerPut{
+			InstancePut: api.InstancePut{
 				Config: map[string]string{
 					"user.user-data": `#cloud-config
 runcmd:
@@ -163,7 +153,7 @@ runcmd:
 			return err
 		}
 
-		err = l.opwait.StartContainer(cName)
+		err = l.currentOpwait().StartInstance(ctx, cName)
 		if err != nil {
 			return err
 		}
@@ -176,7 +166,7 @@ runcmd:
 		stderr := bytes.NewBuffer(nil)
 		stderrW := ioutils.WriteCloserWrapper(stderr)
 
-		code, err := l.Exec(cName, []string{"cloud-init", "status", "-w"}, stdinR, stdoutW, stderrW, false, false, 10, nil)
+		code, err := l.Exec(ctx, cName, []string{"cloud-init", "status", "-w"}, stdinR, stdoutW, stderrW, false, false, 10, nil)
 		if err != nil {
 			return err
 		}
@@ -186,18 +176,18 @@ runcmd:
 		}
 
 		// clean up cloud-init before we create an image
-		_, err = l.Exec(cName, []string{"cloud-init", "clean", "--logs", "--seed"}, stdinR, stdoutW, stderrW, false, false, 10, nil)
+		_, err = l.Exec(ctx, cName, []string{"cloud-init", "clean", "--logs", "--seed"}, stdinR, stdoutW, stderrW, false, false, 10, nil)
 		if err != nil {
 			return err
 		}
 
 		// create the image based on this container
-		err = l.opwait.StopContainer(cName, criTestTimeout, 0)
+		err = l.currentOpwait().StopInstance(ctx, cName, criTestTimeout, 0)
 		if err != nil {
 			return err
 		}
 
-		fingerprint, err := l.opwait.CreateImage(api.ImagesPost{
+		fingerprint, err := l.currentOpwait().CreateImage(ctx, api.ImagesPost{
 			Source: &api.ImagesPostSource{
 				Type: "instance",
 				Name: cName,
@@ -232,7 +222,7 @@ runcmd:
 
 	log.Warnf("CRITest ready: Use --test-images-file=%s in your critest command", imagesFile)
 
-	err = l.opwait.DeleteContainer(cName)
+	err = l.currentOpwait().DeleteInstance(ctx, cName)
 	if err != nil && !IsNotFoundError(err) {
 		return err
 	}
@@ -3,7 +3,7 @@ package lxf
 // Some variable shorthands:
 //  c:    *lxf.Container
 //  s:    *lxf.Sandbox
-//  ct:   *api.Container
+//  ct:   *api.Instance
 //  p:    *api.Profile
 //  l:    *lxf.Client
 //  <x>l: []*<x> | <x> ∈ lxf
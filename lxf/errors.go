@@ -4,11 +4,20 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/automaticserver/lxe/lxf/lxo"
 	"github.com/lxc/lxd/shared/api"
 )
 
-// ErrNotFound for CRI related checks
-var ErrNotFound = errors.New("not found")
+var (
+	// ErrNotFound for CRI related checks
+	ErrNotFound = lxo.ErrNotFound
+	// ErrConflict if the object already exists or was changed in the meantime
+	ErrConflict = lxo.ErrConflict
+	// ErrAlreadyRunning if a container to be started is running
+	ErrAlreadyRunning = lxo.ErrAlreadyRunning
+	// ErrAlreadyStopped if a container to be stopped is stopped
+	ErrAlreadyStopped = lxo.ErrAlreadyStopped
+)
 
 // Error compatibility layer for LXD and custom error for CRI specific checks. The LXD client returns errors as `api.StatusErrorf(resp.StatusCode, response.Error)` where StatusCode is from net/http and Error the string in the response. LXF returns errors defined in this package. Clients of this package should use these functions if they don't want to differentiate these error sources.
 
@@ -20,3 +29,12 @@ func IsNotFoundError(err error) bool {
 
 	return api.StatusErrorCheck(err, http.StatusNotFound)
 }
+
+// Whether err is a conflict error from lxd api response or this package
+func IsConflictError(err error) bool {
+	if errors.Is(err, ErrConflict) {
+		return true
+	}
+
+	return api.StatusErrorCheck(err, http.StatusConflict, http.StatusPreconditionFailed)
+}
@@ -0,0 +1,210 @@
+package lxf
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
+)
+
+// ContainerEventAction is what happened to a container
+type ContainerEventAction string
+
+const (
+	ContainerCreated ContainerEventAction = "created"
+	ContainerUpdated ContainerEventAction = "updated"
+	ContainerStarted ContainerEventAction = "started"
+	ContainerStopped ContainerEventAction = "stopped"
+	ContainerDeleted ContainerEventAction = "deleted"
+)
+
+// ContainerEvent is published for every lifecycle event of a container received from LXD. A restart is published as
+// stopped and started. A container which shut down by itself, like when its init process exited or got killed by the
+// OOM killer, is published as stopped as well, LXD doesn't tell them apart in its lifecycle events.
+type ContainerEvent struct {
+	Action      ContainerEventAction
+	ContainerID string
+	// LXDAction is the lifecycle action of LXD the event was published for
+	LXDAction string
+	Time      time.Time
+}
+
+// EventSubscriber is called for the published container events. The events of a container are passed one after
+// another in the order they were received, the ones of different containers concurrently. The subscribers are called
+// in the order they subscribed. A subscriber which doesn't return holds up the events of all containers of its worker,
+// and once their queue is full, receiving any event from LXD.
+type EventSubscriber func(ctx context.Context, event ContainerEvent)
+
+var (
+	// eventWorkers is the amount of container events processed concurrently
+	eventWorkers = 8
+	// eventQueueSize is the amount of container events waiting per worker, receiving further events is blocked until a
+	// queued one is processed. Events aren't dropped, as a missed started or stopped event leaves a container without
+	// its network set up or torn down.
+	eventQueueSize = 256
+)
+
+// eventBus dispatches container events to the subscribers. The events of a container are always queued for the same
+// worker, so they are processed in order, while a slow subscriber only holds up the events queued for its worker.
+type eventBus struct {
+	queues []chan ContainerEvent
+
+	mu sync.RWMutex
+	// subscribers in the order they subscribed
+	subscribers []subscriber
+	nextID      int
+}
+
+type subscriber struct {
+	id int
+	fn EventSubscriber
+}
+
+func newEventBus(workers, queueSize int) *eventBus {
+	b := &eventBus{
+		queues: make([]chan ContainerEvent, workers),
+	}
+
+	for i := range b.queues {
+		b.queues[i] = make(chan ContainerEvent, queueSize)
+		go b.work(b.queues[i])
+	}
+
+	return b
+}
+
+// subscribe fn to the events and return the function to unsubscribe it again
+func (b *eventBus) subscribe(fn EventSubscriber) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers = append(b.subscribers, subscriber{id: id, fn: fn})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		// copied, as a dispatch might still iterate over the previous subscribers
+		subscribers := make([]subscriber, 0, len(b.subscribers))
+
+		for _, sub := range b.subscribers {
+			if sub.id != id {
+				subscribers = append(subscribers, sub)
+			}
+		}
+
+		b.subscribers = subscribers
+	}
+}
+
+// publish queues the event for the worker of its container, it blocks while that queue is full. The event is never
+// dropped, see eventQueueSize.
+func (b *eventBus) publish(event ContainerEvent) {
+	queue := b.queueOf(event.ContainerID)
+
+	select {
+	case queue <- event:
+	default:
+		log.WithFields(logrus.Fields{"event": event.Action, "containerid": event.ContainerID}).
+			Warn("container event queue is full, waiting for queued events to be processed")
+
+		queue <- event
+	}
+}
+
+// queueOf returns the queue of the worker processing the events of the container
+func (b *eventBus) queueOf(containerID string) chan ContainerEvent {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(containerID))
+
+	return b.queues[h.Sum32()%uint32(len(b.queues))]
+}
+
+func (b *eventBus) work(queue <-chan ContainerEvent) {
+	for event := range queue {
+		b.dispatch(context.Background(), event)
+	}
+}
+
+// dispatch the event to all subscribers, one after another in the order they subscribed
+func (b *eventBus) dispatch(ctx context.Context, event ContainerEvent) {
+	b.mu.RLock()
+	subscribers := b.subscribers
+	b.mu.RUnlock()
+
+	for _, sub := range subscribers {
+		sub.fn(ctx, event)
+	}
+}
+
+// containerEvents returns the container events to publish for the lifecycle event, none if it's not about a container
+func containerEvents(event api.EventLifecycle, at time.Time) []ContainerEvent {
+	var actions []ContainerEventAction
+
+	switch event.Action {
+	case api.EventLifecycleInstanceCreated:
+		actions = []ContainerEventAction{ContainerCreated}
+	case api.EventLifecycleInstanceUpdated:
+		actions = []ContainerEventAction{ContainerUpdated}
+	case api.EventLifecycleInstanceStarted:
+		actions = []ContainerEventAction{ContainerStarted}
+	case api.EventLifecycleInstanceStopped, api.EventLifecycleInstanceShutdown:
+		actions = []ContainerEventAction{ContainerStopped}
+	case api.EventLifecycleInstanceRestarted:
+		actions = []ContainerEventAction{ContainerStopped, ContainerStarted}
+	case api.EventLifecycleInstanceDeleted:
+		actions = []ContainerEventAction{ContainerDeleted}
+	}
+
+	id := GetContainerIDFromSelflink(event.Source)
+	events := make([]ContainerEvent, 0, len(actions))
+
+	for _, action := range actions {
+		events = append(events, ContainerEvent{
+			Action:      action,
+			ContainerID: id,
+			LXDAction:   event.Action,
+			Time:        at,
+		})
+	}
+
+	return events
+}
+
+// eventHandlerSubscriber passes the started and stopped events of cri containers to the event handler
+func (l *client) eventHandlerSubscriber(eh EventHandler) EventSubscriber {
+	return func(ctx context.Context, event ContainerEvent) {
+		if event.Action != ContainerStarted && event.Action != ContainerStopped {
+			return
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"event":       event.LXDAction,
+			"containerid": event.ContainerID,
+		})
+		log.Info("event detected")
+
+		// If the container is not a cri container, we also get "not found", so this container can be ignored
+		c, err := l.GetContainer(ctx, event.ContainerID)
+		if err != nil {
+			log.WithError(err).Error("unable to find container")
+
+			return
+		}
+
+		if event.Action == ContainerStarted {
+			err = eh.ContainerStarted(ctx, c)
+		} else {
+			err = eh.ContainerStopped(ctx, c)
+		}
+
+		if err != nil {
+			log.WithError(err).Error("event handler failed")
+		}
+	}
+}
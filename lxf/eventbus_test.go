@@ -0,0 +1,279 @@
+package lxf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func lifecycleEvent(t *testing.T, action, source string) api.Event {
+	t.Helper()
+
+	metadata, err := json.Marshal(api.EventLifecycle{Action: action, Source: source})
+	assert.NoError(t, err)
+
+	return api.Event{Type: api.EventTypeLifecycle, Timestamp: time.Now(), Metadata: metadata}
+}
+
+// collectEvents subscribes to the bus and returns a function waiting for n events
+func collectEvents(b *eventBus) func(n int) []ContainerEvent {
+	events := make(chan ContainerEvent, 100)
+	b.subscribe(func(ctx context.Context, event ContainerEvent) {
+		events <- event
+	})
+
+	return func(n int) []ContainerEvent {
+		got := []ContainerEvent{}
+
+		for i := 0; i < n; i++ {
+			select {
+			case e := <-events:
+				got = append(got, e)
+			case <-time.After(5 * time.Second):
+				return got
+			}
+		}
+
+		return got
+	}
+}
+
+func TestContainerEvents(t *testing.T) {
+	t.Parallel()
+
+	at := time.Now()
+
+	for _, tc := range []struct {
+		action string
+		want   []ContainerEventAction
+	}{
+		{api.EventLifecycleInstanceCreated, []ContainerEventAction{ContainerCreated}},
+		{api.EventLifecycleInstanceUpdated, []ContainerEventAction{ContainerUpdated}},
+		{api.EventLifecycleInstanceStarted, []ContainerEventAction{ContainerStarted}},
+		{api.EventLifecycleInstanceStopped, []ContainerEventAction{ContainerStopped}},
+		{api.EventLifecycleInstanceShutdown, []ContainerEventAction{ContainerStopped}},
+		{api.EventLifecycleInstanceRestarted, []ContainerEventAction{ContainerStopped, ContainerStarted}},
+		{api.EventLifecycleInstanceDeleted, []ContainerEventAction{ContainerDeleted}},
+		{api.EventLifecycleInstanceExec, []ContainerEventAction{}},
+		{api.EventLifecycleProfileUpdated, []ContainerEventAction{}},
+	} {
+		events := containerEvents(api.EventLifecycle{Action: tc.action, Source: "/1.0/instances/foo"}, at)
+
+		actions := []ContainerEventAction{}
+		for _, e := range events {
+			assert.Equal(t, "foo", e.ContainerID)
+			assert.Equal(t, tc.action, e.LXDAction)
+			assert.Equal(t, at, e.Time)
+
+			actions = append(actions, e.Action)
+		}
+
+		assert.Equal(t, tc.want, actions, tc.action)
+	}
+}
+
+func TestEventBus_OrderedPerContainer(t *testing.T) {
+	t.Parallel()
+
+	b := newEventBus(4, 2)
+
+	var (
+		mu  sync.Mutex
+		got = map[string][]int{}
+	)
+
+	done := make(chan struct{}, 100)
+
+	b.subscribe(func(ctx context.Context, event ContainerEvent) {
+		mu.Lock()
+		got[event.ContainerID] = append(got[event.ContainerID], event.Time.Nanosecond())
+		mu.Unlock()
+
+		done <- struct{}{}
+	})
+
+	for i := 0; i < 10; i++ {
+		for _, id := range []string{"a", "b", "c"} {
+			b.publish(ContainerEvent{Action: ContainerUpdated, ContainerID: id, Time: time.Unix(0, int64(i))})
+		}
+	}
+
+	for i := 0; i < 30; i++ {
+		<-done
+	}
+
+	want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	assert.Equal(t, map[string][]int{"a": want, "b": want, "c": want}, got)
+}
+
+func TestEventBus_SlowSubscriberDoesntBlockOthers(t *testing.T) {
+	t.Parallel()
+
+	b := newEventBus(8, 1)
+
+	// find two containers processed by different workers
+	slow, fast := "slow", ""
+
+	for i := 0; fast == ""; i++ {
+		id := fmt.Sprintf("fast%d", i)
+		if b.queueOf(id) != b.queueOf(slow) {
+			fast = id
+		}
+	}
+
+	release := make(chan struct{})
+	handled := make(chan string, 1)
+
+	b.subscribe(func(ctx context.Context, event ContainerEvent) {
+		if event.ContainerID == slow {
+			<-release
+		}
+
+		handled <- event.ContainerID
+	})
+
+	b.publish(ContainerEvent{Action: ContainerStarted, ContainerID: slow})
+	b.publish(ContainerEvent{Action: ContainerStarted, ContainerID: fast})
+
+	select {
+	case id := <-handled:
+		assert.Equal(t, fast, id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event of other container wasn't processed")
+	}
+
+	close(release)
+	assert.Equal(t, slow, <-handled)
+}
+
+func TestEventBus_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	b := newEventBus(1, 1)
+	wait := collectEvents(b)
+
+	var calls int32
+
+	unsubscribe := b.subscribe(func(ctx context.Context, event ContainerEvent) {
+		atomic.AddInt32(&calls, 1)
+	})
+
+	b.publish(ContainerEvent{ContainerID: "foo"})
+	assert.Len(t, wait(1), 1)
+
+	unsubscribe()
+
+	// a single worker processes the events one after another, so the first one was passed to all subscribers when the
+	// second one arrives
+	b.publish(ContainerEvent{ContainerID: "foo"})
+	assert.Len(t, wait(1), 1)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestClient_lifecycleEventHandler_Publishes(t *testing.T) {
+	t.Parallel()
+
+	c, _ := testClient()
+	wait := collectEvents(c.events)
+
+	c.lifecycleEventHandler(lifecycleEvent(t, api.EventLifecycleInstanceRestarted, "/1.0/instances/foo"))
+	c.lifecycleEventHandler(lifecycleEvent(t, api.EventLifecycleInstanceExec, "/1.0/instances/foo"))
+	c.lifecycleEventHandler(lifecycleEvent(t, api.EventLifecycleInstanceDeleted, "/1.0/instances/foo"))
+
+	got := wait(3)
+	assert.Len(t, got, 3)
+
+	actions := []ContainerEventAction{}
+	for _, e := range got {
+		actions = append(actions, e.Action)
+	}
+
+	assert.Equal(t, []ContainerEventAction{ContainerStopped, ContainerStarted, ContainerDeleted}, actions)
+}
+
+type recordingEventHandler struct {
+	events chan string
+}
+
+func (h *recordingEventHandler) ContainerStarted(ctx context.Context, c *Container) error {
+	h.events <- "started " + c.ID
+
+	return nil
+}
+
+func (h *recordingEventHandler) ContainerStopped(ctx context.Context, c *Container) error {
+	h.events <- "stopped " + c.ID
+
+	return nil
+}
+
+func TestClient_SetEventHandler(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	ct := getSchemaContainer(SchemaVersionContainer)
+	ct.Name = "foo"
+	ct.Profiles = []string{"sandbox"}
+	fake.GetInstanceReturns(satisfyContainerCri(&ct), "etag", nil)
+	fake.GetInstanceStateReturns(&api.InstanceState{}, "", nil)
+
+	h := &recordingEventHandler{events: make(chan string, 10)}
+	c.SetEventHandler(h)
+
+	c.lifecycleEventHandler(lifecycleEvent(t, api.EventLifecycleInstanceCreated, "/1.0/instances/foo"))
+	c.lifecycleEventHandler(lifecycleEvent(t, api.EventLifecycleInstanceShutdown, "/1.0/instances/foo"))
+	c.lifecycleEventHandler(lifecycleEvent(t, api.EventLifecycleInstanceStarted, "/1.0/instances/foo"))
+
+	for _, want := range []string{"stopped foo", "started foo"} {
+		select {
+		case got := <-h.events:
+			assert.Equal(t, want, got)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event handler wasn't called: %s", want)
+		}
+	}
+}
+
+func TestEventBus_SubscriptionOrder(t *testing.T) {
+	t.Parallel()
+
+	b := newEventBus(1, 1)
+
+	var got []int
+
+	done := make(chan struct{})
+	unsubscribes := []func(){}
+
+	for i := 0; i < 10; i++ {
+		i := i
+		unsubscribes = append(unsubscribes, b.subscribe(func(ctx context.Context, event ContainerEvent) {
+			got = append(got, i)
+		}))
+	}
+
+	b.subscribe(func(ctx context.Context, event ContainerEvent) {
+		done <- struct{}{}
+	})
+
+	b.publish(ContainerEvent{ContainerID: "foo"})
+	<-done
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, got)
+
+	unsubscribes[3]()
+	unsubscribes[7]()
+
+	got = nil
+
+	b.publish(ContainerEvent{ContainerID: "foo"})
+	<-done
+	assert.Equal(t, []int{0, 1, 2, 4, 5, 6, 8, 9}, got)
+}
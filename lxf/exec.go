@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -35,8 +36,8 @@ var (
 
 // Exec will start a command on the server and attach the provided streams. It will block till the command terminated
 // AND all data was written to stdout/stdin. The caller is responsible to provide a sink which doesn't block.
-func (l *client) Exec(cid string, cmd []string, stdin io.ReadCloser, stdout, stderr io.WriteCloser, interactive, tty bool, timeout int64, resize <-chan remotecommand.TerminalSize) (int32, error) {
-	log := log.WithFields(logrus.Fields{
+func (l *client) Exec(ctx context.Context, cid string, cmd []string, stdin io.ReadCloser, stdout, stderr io.WriteCloser, interactive, tty bool, timeout int64, resize <-chan remotecommand.TerminalSize) (int32, error) {
+	log := log.WithContext(ctx).WithFields(logrus.Fields{
 		"containerid": cid,
 		"cmd":         cmd,
 		"interactive": interactive,
@@ -54,7 +55,7 @@ func (l *client) Exec(cid string, cmd []string, stdin io.ReadCloser, stdout, std
 		closeResize: make(chan struct{}),
 	}
 
-	req := api.ContainerExecPost{
+	req := api.InstanceExecPost{
 		Command:      cmd,
 		WaitForWS:    true,
 		Interactive:  interactive,
@@ -63,7 +64,7 @@ func (l *client) Exec(cid string, cmd []string, stdin io.ReadCloser, stdout, std
 		Height:       WindowHeightDefault,
 		RecordOutput: false,
 	}
-	args := &lxd.ContainerExecArgs{
+	args := &lxd.InstanceExecArgs{
 		Stdin:    stdin,
 		Stdout:   stdout,
 		Stderr:   stderr,
@@ -71,7 +72,12 @@ func (l *client) Exec(cid string, cmd []string, stdin io.ReadCloser, stdout, std
 		DataDone: make(chan bool),
 	}
 
-	op, err := l.server.ExecContainer(cid, req, args)
+	project, err := l.findProject(cid)
+	if err != nil {
+		return CodeExecError, err
+	}
+
+	op, err := l.projectServer(project).ExecInstance(cid, req, args)
 	if err != nil {
 		return CodeExecError, err
 	}
@@ -96,6 +102,19 @@ func (l *client) Exec(cid string, cmd []string, stdin io.ReadCloser, stdout, std
 
 		return CodeExecTimeout, ErrExecTimeout
 
+	// Exit early if the caller gave up
+	case <-ctx.Done():
+		err := ses.sendCancel()
+		if err != nil {
+			log.WithError(err).Error("session control failed")
+		}
+
+		close(ses.closeResize)
+
+		log.Debugf("Exec cancelled")
+
+		return CodeExecError, ctx.Err()
+
 	// Wait for any remaining I/O to be flushed
 	case <-args.DataDone:
 	}
@@ -177,7 +196,7 @@ func (s *session) sendResize(r remotecommand.TerminalSize) error {
 		return err
 	}
 
-	msg := api.ContainerExecControl{}
+	msg := api.InstanceExecControl{}
 	msg.Command = "window-resize"
 	msg.Args = make(map[string]string)
 	msg.Args["width"] = width
@@ -218,7 +237,7 @@ func (s *session) sendCancel() error {
 	}
 	defer w.Close()
 
-	msg := api.ContainerExecControl{}
+	msg := api.InstanceExecControl{}
 	msg.Command = "signal"
 	msg.Signal = int(sig)
 
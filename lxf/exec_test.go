@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"strconv"
 	"sync"
 	"testing"
@@ -20,7 +21,7 @@ func TestClient_Exec_BasicOk(t *testing.T) {
 	client, fake := testClient()
 	fakeOp := &lxdfakes.FakeOperation{}
 
-	fake.ExecContainerCalls(func(arg1 string, arg2 api.ContainerExecPost, arg3 *lxd.ContainerExecArgs) (lxd.Operation, error) {
+	fake.ExecInstanceCalls(func(arg1 string, arg2 api.InstanceExecPost, arg3 *lxd.InstanceExecArgs) (lxd.Operation, error) {
 		go sendDataDone(arg3, 0)
 
 		return fakeOp, nil
@@ -33,7 +34,7 @@ func TestClient_Exec_BasicOk(t *testing.T) {
 		},
 	})
 
-	exitCode, err := client.Exec("", nil, nil, nil, nil, false, false, 0, nil)
+	exitCode, err := client.Exec(context.Background(), "", nil, nil, nil, nil, false, false, 0, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, CodeExecError, exitCode)
 }
@@ -47,7 +48,7 @@ func TestClient_Exec_Timeout(t *testing.T) {
 
 	var fakeControl *websocket.Conn
 
-	fake.ExecContainerCalls(func(arg1 string, arg2 api.ContainerExecPost, arg3 *lxd.ContainerExecArgs) (lxd.Operation, error) {
+	fake.ExecInstanceCalls(func(arg1 string, arg2 api.InstanceExecPost, arg3 *lxd.InstanceExecArgs) (lxd.Operation, error) {
 		arg3.Control = fakeSes.controlHandler
 		arg3.Control(fakeControl)
 		go sendDataDone(arg3, 1200*time.Millisecond)
@@ -62,7 +63,7 @@ func TestClient_Exec_Timeout(t *testing.T) {
 		},
 	})
 
-	exitCode, err := client.Exec("", nil, nil, nil, nil, false, false, 1, nil)
+	exitCode, err := client.Exec(context.Background(), "", nil, nil, nil, nil, false, false, 1, nil)
 	assert.Error(t, err)
 	assert.Exactly(t, ErrExecTimeout, err)
 	assert.Equal(t, CodeExecTimeout, exitCode)
@@ -80,7 +81,7 @@ func TestClient_Exec_Resize(t *testing.T) {
 
 	var fakeControl *websocket.Conn
 
-	fake.ExecContainerCalls(func(arg1 string, arg2 api.ContainerExecPost, arg3 *lxd.ContainerExecArgs) (lxd.Operation, error) {
+	fake.ExecInstanceCalls(func(arg1 string, arg2 api.InstanceExecPost, arg3 *lxd.InstanceExecArgs) (lxd.Operation, error) {
 		arg3.Control = fakeSes.controlHandler
 		arg3.Control(fakeControl)
 		go sendDataDone(arg3, 0)
@@ -95,7 +96,7 @@ func TestClient_Exec_Resize(t *testing.T) {
 		},
 	})
 
-	exitCode, err := client.Exec("", nil, nil, nil, nil, false, false, 0, fakeSes.resize)
+	exitCode, err := client.Exec(context.Background(), "", nil, nil, nil, nil, false, false, 0, fakeSes.resize)
 	assert.NoError(t, err)
 	assert.Equal(t, CodeExecOk, exitCode)
 
@@ -116,7 +117,7 @@ func TestClient_Exec_Parallel(t *testing.T) {
 
 	// take the first command argument as a number and use that to return to ensure the a specific command gets the
 	// matching return
-	fake.ExecContainerCalls(func(arg1 string, arg2 api.ContainerExecPost, arg3 *lxd.ContainerExecArgs) (lxd.Operation, error) {
+	fake.ExecInstanceCalls(func(arg1 string, arg2 api.InstanceExecPost, arg3 *lxd.InstanceExecArgs) (lxd.Operation, error) {
 		go sendDataDone(arg3, 0)
 
 		fakeOp := &lxdfakes.FakeOperation{}
@@ -140,7 +141,7 @@ func TestClient_Exec_Parallel(t *testing.T) {
 
 	for i := 0; i < n; i++ {
 		go func(i int) {
-			exitCode, err := client.Exec("", []string{strconv.Itoa(i)}, nil, nil, nil, false, false, 0, nil)
+			exitCode, err := client.Exec(context.Background(), "", []string{strconv.Itoa(i)}, nil, nil, nil, false, false, 0, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, int32(i), exitCode)
 			wg.Done()
@@ -283,7 +284,7 @@ func TestClient_Exec_Parallel(t *testing.T) {
 // }
 
 // An independent routine within fake sends to this channel after ExecContainer, not Wait() related, so we'll send it here
-func sendDataDone(args *lxd.ContainerExecArgs, sleep time.Duration) {
+func sendDataDone(args *lxd.InstanceExecArgs, sleep time.Duration) {
 	if sleep > 0 {
 		time.Sleep(1200 * time.Millisecond)
 	}
@@ -1,72 +1,173 @@
 package lxf
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dionysius/errand"
 	lxd "github.com/lxc/lxd/client"
 	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
 )
 
-const lxeAliasPrefix = "lxe/"
+const (
+	lxeAliasPrefix = "lxe/"
+	// lxeVMAliasPrefix is used instead of lxeAliasPrefix for the images of virtual machines, so both variants of an
+	// image can be pulled. No image name starts with "@".
+	lxeVMAliasPrefix = lxeAliasPrefix + "@vm/"
+
+	cfgImageRemote   = "user.lxe.remote"
+	cfgImagePinned   = "user.lxe.pinned"
+	cfgImageUsername = "user.lxe.username"
+)
 
 // Image is here to translate the relevant data from lxd image to cri image
 type Image struct {
 	Hash    string
 	Aliases []string
 	Size    int64
+	// Remote the image was pulled from, empty if unknown
+	Remote string
+	// Architecture the image is built for
+	Architecture string
+	// Type of the instances the image is for
+	Type InstanceType
+	// Properties of the image like os, release, variant and description
+	Properties map[string]string
+	// Username the processes of the image run as by default, empty if unknown
+	Username   string
+	CreatedAt  time.Time
+	UploadedAt time.Time
+	// Pinned images must not be garbage collected
+	Pinned bool
 }
 
-// PullImage copies the given image from the remote server. The image is remembered by setting a specific alias.
-func (l *client) PullImage(image string) (string, error) {
-	orig := image
+// ImageSource describes where a requested image would be pulled from
+type ImageSource struct {
+	// Remote is the name of the remote the image is found on
+	Remote string
+	// Fingerprint of the image on that remote
+	Fingerprint string
+}
 
-	if l.critestMode {
-		image = replaceCRITestImage(image, l.config.DefaultRemote)
+// ImageSourceCheck decides whether the image may be pulled from the source, it returns an error to skip the source
+type ImageSourceCheck func(src *ImageSource) error
+
+// resolveImage looks up the image of the instance type on the remote specified in source. If arch is empty the image
+// variant is chosen which the node can run.
+func (l *client) resolveImage(source, arch string, typ InstanceType) (string, *api.Image, error) {
+	remote, aliasOrFingerprint, err := l.config.ParseRemote(source)
+	if err != nil {
+		return "", nil, err
 	}
 
-	remote, aliasOrFingerprint, err := l.config.ParseRemote(image)
+	imgServer, err := l.imageServer(remote)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	archs := []string{arch}
+	if arch == "" {
+		archs, err = l.architectures()
+		if err != nil {
+			return "", nil, err
+		}
 	}
 
-	// get the image server for the remote also when it's the explicitly defined default (local) remote
-	imgServer, err := l.config.GetImageServer(remote)
+	lxdImg, err := getRemoteImageForArchitectures(imgServer, aliasOrFingerprint, typ, archs)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	return remote, lxdImg, nil
+}
+
+// imageServer returns the image server for the remote. The default remote is the server lxe is managing, so images
+// are looked up in the same project.
+func (l *client) imageServer(remote string) (lxd.ImageServer, error) { // nolint: ireturn
+	if remote == l.config.DefaultRemote {
+		return l.currentServer(), nil
 	}
 
-	lxdImg, err := getRemoteImageFromAliasOrFingerprint(imgServer, aliasOrFingerprint)
+	return l.config.GetImageServer(remote)
+}
+
+// PullImage copies the given image from the remote server. The image is remembered by setting a specific alias.
+// If the image rewrite rules match the image, the rewritten images are tried in order instead, but the requested name is
+// still used for the alias. The image variant for the instance type is pulled, a container image if typ is empty.
+// Every source found is passed to check before it's pulled, a rejected one is skipped.
+func (l *client) PullImage(ctx context.Context, image string, typ InstanceType, check ImageSourceCheck) (string, error) {
+	var errs error
+
+	log := log.WithContext(ctx)
+	rules := l.imageRewriteRules()
+
+	for _, source := range rules.Rewrite(image) {
+		// no further sources are tried if the caller gave up
+		if ctx.Err() != nil {
+			return "", errand.Append(errs, ctx.Err())
+		}
+
+		if source != image {
+			log.WithFields(logrus.Fields{"image": image, "source": source}).Info("rewriting requested image")
+		}
+
+		fingerprint, err := l.pullImage(ctx, image, source, rules.Architecture(image), typ, check)
+		if err == nil {
+			return fingerprint, nil
+		}
+
+		log.WithError(err).WithFields(logrus.Fields{"image": image, "source": source}).Warn("unable to pull image from source")
+
+		errs = errand.Append(errs, err)
+	}
+
+	return "", errs
+}
+
+// pullImage copies the image from source if check accepts it and remembers it under the requested image name
+func (l *client) pullImage(ctx context.Context, image, source, arch string, typ InstanceType, check ImageSourceCheck) (string, error) {
+	remote, lxdImg, err := l.resolveImage(source, arch, typ)
 	if err != nil {
 		return "", err
 	}
 
+	if check != nil {
+		err = check(&ImageSource{Remote: remote, Fingerprint: lxdImg.Fingerprint})
+		if err != nil {
+			return "", err
+		}
+	}
+
 	// copy only if it is a foreign remote
 	if remote != l.config.DefaultRemote {
+		imgServer, err := l.imageServer(remote)
+		if err != nil {
+			return "", err
+		}
+
 		args := lxd.ImageCopyArgs{
 			CopyAliases: false,
 		}
 
-		err = l.opwait.CopyImage(imgServer, *lxdImg, &args)
+		err = l.currentOpwait().CopyImage(ctx, imgServer, *lxdImg, &args)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	setAlias := lxeAlias(image)
-
-	if l.critestMode {
-		setAlias = lxeAlias(orig)
-	} else {
-		err = l.ensureCRIImage(lxdImg.Fingerprint)
+	// in critest mode the source images are shared base images which must not be marked as cri images
+	if !l.critestMode {
+		err = l.ensureCRIImage(lxdImg.Fingerprint, remote)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	err = l.ensureImageAlias(setAlias, lxdImg.Fingerprint)
+	err = l.ensureImageAlias(lxeTypeAlias(image, typ), lxdImg.Fingerprint)
 	if err != nil {
 		return "", err
 	}
@@ -75,7 +176,7 @@ func (l *client) PullImage(image string) (string, error) {
 }
 
 // RemoveImage will remove a pulled image
-func (l *client) RemoveImage(image string) error {
+func (l *client) RemoveImage(ctx context.Context, image string) error {
 	if l.critestMode {
 		return l.removeCRITestImage(image)
 	}
@@ -85,7 +186,7 @@ func (l *client) RemoveImage(image string) error {
 		return err
 	}
 
-	err = l.opwait.DeleteImage(lxdImg.Fingerprint)
+	err = l.currentOpwait().DeleteImage(ctx, lxdImg.Fingerprint)
 	if err != nil {
 		return err
 	}
@@ -98,13 +199,27 @@ func lxeAlias(image string) string {
 	return fmt.Sprintf("%s%s", lxeAliasPrefix, strings.Replace(image, ":", "/", 1))
 }
 
+// Return the alias how lxe is remembering the image pulled for the instance type
+func lxeTypeAlias(image string, typ InstanceType) string {
+	if typ == InstanceTypeVirtualMachine {
+		return fmt.Sprintf("%s%s", lxeVMAliasPrefix, strings.Replace(image, ":", "/", 1))
+	}
+
+	return lxeAlias(image)
+}
+
 // Reverts the lxe specific alias modification
 func revLxeAlias(alias string) string {
+	if strings.HasPrefix(alias, lxeVMAliasPrefix) {
+		return strings.TrimPrefix(alias, lxeVMAliasPrefix)
+	}
+
 	return strings.TrimPrefix(alias, lxeAliasPrefix)
 }
 
-// Set a cri field property so we know which images are part of the CRI
-func (l *client) ensureCRIImage(fingerprint string) error {
+// Set a cri field property so we know which images are part of the CRI and remember where it was pulled from and which
+// architecture was chosen
+func (l *client) ensureCRIImage(fingerprint, remote string) error {
 	lxdImg, err := l.getLocalImageFromAliasOrFingerprint(fingerprint)
 	if err != nil {
 		return err
@@ -115,14 +230,16 @@ func (l *client) ensureCRIImage(fingerprint string) error {
 	}
 
 	lxdImg.Properties[cfgIsCRI] = strconv.FormatBool(true)
+	lxdImg.Properties[cfgImageRemote] = remote
+	lxdImg.Properties[cfgImageArchitecture] = lxdImg.Architecture
 
-	return l.server.UpdateImage(lxdImg.Fingerprint, lxdImg.Writable(), "")
+	return l.currentServer().UpdateImage(lxdImg.Fingerprint, lxdImg.Writable(), "")
 }
 
 // Create the specified image alias, update if already exist
 // from github.com/lxc/lxd/lxc/image.go:172 + changes
 func (l *client) ensureImageAlias(alias string, fingerprint string) error {
-	current, err := l.server.GetImageAliases()
+	current, err := l.currentServer().GetImageAliases()
 	if err != nil {
 		return err
 	}
@@ -138,7 +255,7 @@ func (l *client) ensureImageAlias(alias string, fingerprint string) error {
 				break
 			}
 
-			err = l.server.DeleteImageAlias(ca.Name)
+			err = l.currentServer().DeleteImageAlias(ca.Name)
 			if err != nil {
 				return fmt.Errorf("failed to delete alias for update: %v, %w", alias, err)
 			}
@@ -155,7 +272,7 @@ func (l *client) ensureImageAlias(alias string, fingerprint string) error {
 	aliasPost.Name = alias
 	aliasPost.Target = fingerprint
 
-	err = l.server.CreateImageAlias(aliasPost)
+	err = l.currentServer().CreateImageAlias(aliasPost)
 	if err != nil {
 		return fmt.Errorf("failed to create alias: %v, %w", alias, err)
 	}
@@ -163,11 +280,11 @@ func (l *client) ensureImageAlias(alias string, fingerprint string) error {
 	return nil
 }
 
-// ListImages will list all pulled images
-func (l *client) ListImages(filter string) ([]*Image, error) {
+// ListImages will list all pulled images. The filter matches a fingerprint or an image name by its prefix
+func (l *client) ListImages(ctx context.Context, filter string) ([]*Image, error) {
 	response := []*Image{}
 
-	imglist, err := l.server.GetImages()
+	imglist, err := l.currentServer().GetImages()
 	if err != nil {
 		return nil, fmt.Errorf("unable to list images: %w", err)
 	}
@@ -179,7 +296,7 @@ func (l *client) ListImages(filter string) ([]*Image, error) {
 			continue
 		}
 
-		if filter != "" && filter != lxdImg.Fingerprint {
+		if filter != "" && !imageMatchesFilter(&lxdImg, filter) {
 			continue
 		}
 
@@ -190,7 +307,7 @@ func (l *client) ListImages(filter string) ([]*Image, error) {
 }
 
 // GetImage will fetch information about a pulled image
-func (l *client) GetImage(image string) (*Image, error) {
+func (l *client) GetImage(ctx context.Context, image string) (*Image, error) {
 	lxdImg, err := l.getLocalImageFromAliasOrFingerprint(image)
 	if err != nil {
 		return nil, err
@@ -203,46 +320,61 @@ func (l *client) GetImage(image string) (*Image, error) {
 	return toImage(lxdImg), nil
 }
 
-func getImageFingerprint(imgServer lxd.ImageServer, alias string) (string, error) {
-	lxdAlias, _, err := imgServer.GetImageAlias(alias)
+// GetImageVariant will fetch information about the image pulled for the instance type
+func (l *client) GetImageVariant(ctx context.Context, image string, typ InstanceType) (*Image, error) {
+	fingerprint, err := getImageFingerprint(l.currentServer(), lxeTypeAlias(image, typ))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return lxdAlias.Target, err
+	lxdImg, _, err := l.currentServer().GetImage(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	if !l.IsCRI(lxdImg) {
+		return nil, ErrNotFound
+	}
+
+	return toImage(lxdImg), nil
 }
 
-// similar to l.getLocalImageFromAliasOrFingerprint but remote aliases wont have the lxe specific prefix
-func getRemoteImageFromAliasOrFingerprint(imgServer lxd.ImageServer, aliasOrFingerprint string) (*api.Image, error) {
-	// try to find out if aliasOrFingerprint is a known alias on the server
-	fingerprint, err := getImageFingerprint(imgServer, aliasOrFingerprint)
-	if err != nil {
-		// if the alias is not found, try to find it by fingerprint
-		if IsNotFoundError(err) {
-			lxdImg, _, err := imgServer.GetImage(aliasOrFingerprint)
+// imageMatchesFilter reports whether the image has the given fingerprint prefix or a lxe alias with the given prefix
+func imageMatchesFilter(lxdImg *api.Image, filter string) bool {
+	if strings.HasPrefix(lxdImg.Fingerprint, filter) {
+		return true
+	}
 
-			return lxdImg, err
+	for _, a := range lxdImg.Aliases {
+		if strings.HasPrefix(a.Name, lxeAlias(filter)) || strings.HasPrefix(a.Name, lxeTypeAlias(filter, InstanceTypeVirtualMachine)) {
+			return true
 		}
-
-		return nil, err
 	}
 
-	// get the image from alias' target fingerprint
-	lxdImg, _, err := imgServer.GetImage(fingerprint)
+	return false
+}
+
+func getImageFingerprint(imgServer lxd.ImageServer, alias string) (string, error) {
+	lxdAlias, _, err := imgServer.GetImageAlias(alias)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	return lxdImg, nil
+	return lxdAlias.Target, err
 }
 
+// getLocalImageFromAliasOrFingerprint prefers the image pulled for containers if both variants are pulled
 func (l *client) getLocalImageFromAliasOrFingerprint(aliasOrFingerprint string) (*api.Image, error) {
 	// try to find out if aliasOrFingerprint is a known alias on the server
-	fingerprint, err := getImageFingerprint(l.server, lxeAlias(aliasOrFingerprint))
+	fingerprint, err := getImageFingerprint(l.currentServer(), lxeAlias(aliasOrFingerprint))
+	if IsNotFoundError(err) {
+		fingerprint, err = getImageFingerprint(l.currentServer(), lxeTypeAlias(aliasOrFingerprint, InstanceTypeVirtualMachine))
+	}
+
 	if err != nil {
 		// if the alias is not found, try to find it by fingerprint
 		if IsNotFoundError(err) {
-			lxdImg, _, err := l.server.GetImage(aliasOrFingerprint)
+			lxdImg, _, err := l.currentServer().GetImage(aliasOrFingerprint)
 
 			return lxdImg, err
 		}
@@ -251,7 +383,7 @@ func (l *client) getLocalImageFromAliasOrFingerprint(aliasOrFingerprint string)
 	}
 
 	// get the image from alias' target fingerprint
-	lxdImg, _, err := l.server.GetImage(fingerprint)
+	lxdImg, _, err := l.currentServer().GetImage(fingerprint)
 	if err != nil {
 		return nil, err
 	}
@@ -268,8 +400,8 @@ type FSPoolUsage struct {
 }
 
 // GetFSPoolUsage returns a list of usage information about the used storage pools
-func (l *client) GetFSPoolUsage() ([]FSPoolUsage, error) {
-	pools, err := l.server.GetStoragePools()
+func (l *client) GetFSPoolUsage(ctx context.Context) ([]FSPoolUsage, error) {
+	pools, err := l.currentServer().GetStoragePools()
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +409,7 @@ func (l *client) GetFSPoolUsage() ([]FSPoolUsage, error) {
 	rval := []FSPoolUsage{}
 
 	for _, pool := range pools {
-		pRcs, err := l.server.GetStoragePoolResources(pool.Name)
+		pRcs, err := l.currentServer().GetStoragePoolResources(pool.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -295,8 +427,24 @@ func (l *client) GetFSPoolUsage() ([]FSPoolUsage, error) {
 
 func toImage(lxdImg *api.Image) *Image {
 	img := &Image{
-		Hash: lxdImg.Fingerprint,
-		Size: lxdImg.Size,
+		Hash:         lxdImg.Fingerprint,
+		Size:         lxdImg.Size,
+		Remote:       lxdImg.Properties[cfgImageRemote],
+		Architecture: lxdImg.Architecture,
+		Type:         InstanceType(lxdImg.Type),
+		Properties:   map[string]string{},
+		Username:     lxdImg.Properties[cfgImageUsername],
+		CreatedAt:    lxdImg.CreatedAt,
+		UploadedAt:   lxdImg.UploadedAt,
+	}
+
+	img.Pinned = isPinnedImage(*lxdImg)
+
+	for k, v := range lxdImg.Properties {
+		// lxe specific properties are already represented by fields
+		if !strings.HasPrefix(k, "user.") {
+			img.Properties[k] = v
+		}
 	}
 
 	for _, a := range lxdImg.Aliases {
@@ -1,9 +1,12 @@
 package lxf
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"testing"
 
+	"github.com/lxc/lxd/lxc/config"
 	"github.com/lxc/lxd/shared/api"
 	"github.com/stretchr/testify/assert"
 )
@@ -22,19 +25,19 @@ import (
 // > return nil, "", api.StatusErrorf(resp.StatusCode, response.Error)
 //
 // errors can return status text "not found" but will have http.NotFound as code. This test succeeds if it executes the second call to the fake server, which is GetImage().
-func Test_getRemoteImageFromAliasOrFingerprint_AliasNotFoundPreLXD5(t *testing.T) {
+func Test_getRemoteImageForArchitectures_AliasNotFoundPreLXD5(t *testing.T) {
 	t.Parallel()
 
 	c, fake := testClient()
 
-	fake.GetImageAliasReturns(nil, "", api.StatusErrorf(http.StatusNotFound, http.StatusText(http.StatusNotFound)))
+	fake.GetImageAliasArchitecturesReturns(nil, api.StatusErrorf(http.StatusNotFound, http.StatusText(http.StatusNotFound)))
 	fake.GetImageReturns(&api.Image{Fingerprint: "abcdefg"}, "", nil)
 
-	resp, err := getRemoteImageFromAliasOrFingerprint(c.server, "ubuntu:nextgen")
+	resp, err := getRemoteImageForArchitectures(c.server, "ubuntu:nextgen", InstanceTypeContainer, []string{"x86_64"})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "abcdefg", resp.Fingerprint)
-	assert.Equal(t, 1, fake.GetImageAliasCallCount())
+	assert.Equal(t, 1, fake.GetImageAliasArchitecturesCallCount())
 	assert.Equal(t, 1, fake.GetImageCallCount())
 }
 
@@ -48,19 +51,19 @@ func Test_getRemoteImageFromAliasOrFingerprint_AliasNotFoundPreLXD5(t *testing.T
 // > return nil, "", api.StatusErrorf(resp.StatusCode, response.Error)
 //
 // That error now definitely returns the error text with http.NotFound as code. This test succeeds if it executes the second call to the fake server, which is GetImage().
-func Test_getRemoteImageFromAliasOrFingerprint_AliasNotFoundPostLXD5(t *testing.T) {
+func Test_getRemoteImageForArchitectures_AliasNotFoundPostLXD5(t *testing.T) {
 	t.Parallel()
 
 	c, fake := testClient()
 
-	fake.GetImageAliasReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
+	fake.GetImageAliasArchitecturesReturns(nil, api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
 	fake.GetImageReturns(&api.Image{Fingerprint: "abcdefg"}, "", nil)
 
-	resp, err := getRemoteImageFromAliasOrFingerprint(c.server, "ubuntu:nextgen")
+	resp, err := getRemoteImageForArchitectures(c.server, "ubuntu:nextgen", InstanceTypeContainer, []string{"x86_64"})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "abcdefg", resp.Fingerprint)
-	assert.Equal(t, 1, fake.GetImageAliasCallCount())
+	assert.Equal(t, 1, fake.GetImageAliasArchitecturesCallCount())
 	assert.Equal(t, 1, fake.GetImageCallCount())
 }
 
@@ -72,20 +75,20 @@ func Test_getRemoteImageFromAliasOrFingerprint_AliasNotFoundPostLXD5(t *testing.
 // > return &errorResponse{httpStatusCode, http.StatusText(httpStatusCode)}
 // https://github.com/lxc/lxd/blob/lxd-5.0.0/client/lxd.go#L228
 // > return nil, "", api.StatusErrorf(resp.StatusCode, response.Error)
-func Test_getRemoteImageFromAliasOrFingerprint_ImageNotFoundPreLXD5(t *testing.T) {
+func Test_getRemoteImageForArchitectures_ImageNotFoundPreLXD5(t *testing.T) {
 	t.Parallel()
 
 	c, fake := testClient()
 
-	fake.GetImageAliasReturns(nil, "", api.StatusErrorf(http.StatusNotFound, http.StatusText(http.StatusNotFound)))
+	fake.GetImageAliasArchitecturesReturns(nil, api.StatusErrorf(http.StatusNotFound, http.StatusText(http.StatusNotFound)))
 	fake.GetImageReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Image not found"))
 
-	resp, err := getRemoteImageFromAliasOrFingerprint(c.server, "ubuntu:nextgen")
+	resp, err := getRemoteImageForArchitectures(c.server, "ubuntu:nextgen", InstanceTypeContainer, []string{"x86_64"})
 
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.True(t, IsNotFoundError(err))
-	assert.Equal(t, 1, fake.GetImageAliasCallCount())
+	assert.Equal(t, 1, fake.GetImageAliasArchitecturesCallCount())
 	assert.Equal(t, 1, fake.GetImageCallCount())
 }
 
@@ -97,19 +100,201 @@ func Test_getRemoteImageFromAliasOrFingerprint_ImageNotFoundPreLXD5(t *testing.T
 // > return &errorResponse{statusCode, err.Error()}
 // https://github.com/lxc/lxd/blob/lxd-5.1/client/lxd.go#L228
 // > return nil, "", api.StatusErrorf(resp.StatusCode, response.Error)
-func Test_getRemoteImageFromAliasOrFingerprint_ImageNotFoundPostLXD5(t *testing.T) {
+func Test_getRemoteImageForArchitectures_ImageNotFoundPostLXD5(t *testing.T) {
 	t.Parallel()
 
 	c, fake := testClient()
 
-	fake.GetImageAliasReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
+	fake.GetImageAliasArchitecturesReturns(nil, api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
 	fake.GetImageReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Image not found"))
 
-	resp, err := getRemoteImageFromAliasOrFingerprint(c.server, "ubuntu:nextgen")
+	resp, err := getRemoteImageForArchitectures(c.server, "ubuntu:nextgen", InstanceTypeContainer, []string{"x86_64"})
 
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.True(t, IsNotFoundError(err))
-	assert.Equal(t, 1, fake.GetImageAliasCallCount())
+	assert.Equal(t, 1, fake.GetImageAliasArchitecturesCallCount())
 	assert.Equal(t, 1, fake.GetImageCallCount())
 }
+
+func TestClient_ListImages_Filter(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	jammy := getCRIImage("true")
+	jammy.Fingerprint = "aaaa1111"
+	jammy.Aliases = []api.ImageAlias{{Name: lxeAlias("images:ubuntu/jammy")}}
+
+	alpine := getCRIImage("true")
+	alpine.Fingerprint = "bbbb2222"
+	alpine.Aliases = []api.ImageAlias{{Name: lxeAlias("images:alpine/edge")}}
+
+	fake.GetImagesReturns([]api.Image{jammy, alpine}, nil)
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{"", []string{"aaaa1111", "bbbb2222"}},
+		{"bbbb2222", []string{"bbbb2222"}},
+		{"aaaa", []string{"aaaa1111"}},
+		{"images:ubuntu/jammy", []string{"aaaa1111"}},
+		{"images:ubuntu", []string{"aaaa1111"}},
+		{"images:", []string{"aaaa1111", "bbbb2222"}},
+		{"ubuntu:jammy", []string{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.filter, func(t *testing.T) {
+			t.Parallel()
+
+			imgs, err := c.ListImages(context.Background(), tt.filter)
+			assert.NoError(t, err)
+
+			got := []string{}
+			for _, img := range imgs {
+				got = append(got, img.Hash)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_toImage(t *testing.T) {
+	t.Parallel()
+
+	lxdImg := getCRIImage("true")
+	lxdImg.Fingerprint = "aaaa1111"
+	lxdImg.Architecture = "x86_64"
+	lxdImg.Properties["os"] = "ubuntu"
+	lxdImg.Properties[cfgImagePinned] = "true"
+	lxdImg.Properties[cfgImageRemote] = "images"
+	lxdImg.Properties[cfgImageUsername] = "ubuntu"
+	lxdImg.Aliases = []api.ImageAlias{{Name: lxeAlias("images:ubuntu/jammy")}, {Name: "other"}}
+
+	img := toImage(&lxdImg)
+
+	assert.Equal(t, "aaaa1111", img.Hash)
+	assert.Equal(t, "x86_64", img.Architecture)
+	assert.Equal(t, map[string]string{"os": "ubuntu"}, img.Properties)
+	assert.Equal(t, "images", img.Remote)
+	assert.Equal(t, "ubuntu", img.Username)
+	assert.True(t, img.Pinned)
+	assert.Equal(t, []string{"images/ubuntu/jammy"}, img.Aliases)
+}
+
+func TestClient_PullImage_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.PullImage(ctx, "ubuntu:nextgen", "", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, fake.GetImageAliasArchitecturesCallCount())
+}
+
+func TestClient_PullImage_CheckEverySource(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	c.config = &config.Config{DefaultRemote: "local", Remotes: map[string]config.Remote{"local": {Addr: "unix://"}}}
+	c.rewriteRules = ImageRewriteRules{{Match: "local:app", Targets: []string{"local:rejected", "local:allowed"}}}
+
+	fake.GetServerReturns(&api.Server{Environment: api.ServerEnvironment{Architectures: []string{"x86_64"}}}, "", nil)
+	fake.GetImageAliasArchitecturesReturns(nil, api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
+	fake.GetImageAliasReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Image alias not found"))
+	fake.GetImageCalls(func(fingerprint string) (*api.Image, string, error) {
+		return &api.Image{Fingerprint: fingerprint}, "", nil
+	})
+
+	checked := []string{}
+
+	fingerprint, err := c.PullImage(context.Background(), "local:app", InstanceTypeContainer, func(src *ImageSource) error {
+		checked = append(checked, src.Fingerprint)
+		if src.Fingerprint == "rejected" {
+			return errors.New("rejected")
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "allowed", fingerprint)
+	assert.Equal(t, []string{"rejected", "allowed"}, checked)
+
+	// only the allowed image is touched
+	assert.Equal(t, 1, fake.UpdateImageCallCount())
+
+	updated, _, _ := fake.UpdateImageArgsForCall(0)
+	assert.Equal(t, "allowed", updated)
+}
+
+func Test_lxeTypeAlias(t *testing.T) {
+	t.Parallel()
+
+	container := lxeTypeAlias("images:ubuntu/jammy", InstanceTypeContainer)
+	vm := lxeTypeAlias("images:ubuntu/jammy", InstanceTypeVirtualMachine)
+
+	assert.Equal(t, lxeAlias("images:ubuntu/jammy"), container)
+	assert.Equal(t, container, lxeTypeAlias("images:ubuntu/jammy", ""))
+	assert.NotEqual(t, container, vm)
+	assert.Equal(t, "images/ubuntu/jammy", revLxeAlias(container))
+	assert.Equal(t, "images/ubuntu/jammy", revLxeAlias(vm))
+}
+
+func TestClient_GetImageVariant(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	container := getCRIImage("true")
+	container.Fingerprint = "aaaa1111"
+	container.Type = string(InstanceTypeContainer)
+
+	vm := getCRIImage("true")
+	vm.Fingerprint = "bbbb2222"
+	vm.Type = string(InstanceTypeVirtualMachine)
+
+	aliases := map[string]string{
+		lxeTypeAlias("images:ubuntu/jammy", InstanceTypeContainer):      container.Fingerprint,
+		lxeTypeAlias("images:ubuntu/jammy", InstanceTypeVirtualMachine): vm.Fingerprint,
+	}
+	images := map[string]api.Image{container.Fingerprint: container, vm.Fingerprint: vm}
+
+	fake.GetImageAliasCalls(func(name string) (*api.ImageAliasesEntry, string, error) {
+		target, has := aliases[name]
+		if !has {
+			return nil, "", api.StatusErrorf(http.StatusNotFound, "Image alias not found")
+		}
+
+		return &api.ImageAliasesEntry{Name: name, ImageAliasesEntryPut: api.ImageAliasesEntryPut{Target: target}}, "", nil
+	})
+	fake.GetImageCalls(func(fingerprint string) (*api.Image, string, error) {
+		img := images[fingerprint]
+
+		return &img, "", nil
+	})
+
+	img, err := c.GetImageVariant(context.Background(), "images:ubuntu/jammy", InstanceTypeVirtualMachine)
+	assert.NoError(t, err)
+	assert.Equal(t, "bbbb2222", img.Hash)
+	assert.Equal(t, InstanceTypeVirtualMachine, img.Type)
+
+	img, err = c.GetImageVariant(context.Background(), "images:ubuntu/jammy", InstanceTypeContainer)
+	assert.NoError(t, err)
+	assert.Equal(t, "aaaa1111", img.Hash)
+
+	// the name alone finds the container variant
+	img, err = c.GetImage(context.Background(), "images:ubuntu/jammy")
+	assert.NoError(t, err)
+	assert.Equal(t, "aaaa1111", img.Hash)
+
+	_, err = c.GetImageVariant(context.Background(), "images:alpine/edge", InstanceTypeVirtualMachine)
+	assert.True(t, IsNotFoundError(err))
+}
@@ -0,0 +1,170 @@
+package lxf
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dionysius/errand"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
+)
+
+// ImageReconcileOptions control how images are reconciled
+type ImageReconcileOptions struct {
+	// Cleanup removes dangling aliases and unused images, otherwise they are only reported
+	Cleanup bool
+	// MinAge an image must have been uploaded or last used before it is considered unused. This prevents removing
+	// images which were just pulled but aren't used by a container yet.
+	MinAge time.Duration
+}
+
+// ImageReconcileReport lists the findings of an image reconciliation
+type ImageReconcileReport struct {
+	// DanglingAliases are lxe aliases whose image doesn't exist anymore
+	DanglingAliases []string `json:"danglingAliases" yaml:"danglingAliases"`
+	// UnusedImages are fingerprints of cri images no container is based on
+	UnusedImages []string `json:"unusedImages" yaml:"unusedImages"`
+	// DuplicateAliases lists per fingerprint all lxe aliases pointing to it, if there are several. They are only reported
+	// as every alias is a name the kubelet might refer to.
+	DuplicateAliases map[string][]string `json:"duplicateAliases" yaml:"duplicateAliases"`
+	// Removed is true if the dangling aliases and unused images have been removed
+	Removed bool `json:"removed" yaml:"removed"`
+}
+
+// ReconcileImages finds dangling lxe aliases, unused cri images and duplicate lxe aliases. Pinned images and images
+// any container is based on are never considered unused.
+func (l *client) ReconcileImages(ctx context.Context, opts ImageReconcileOptions) (*ImageReconcileReport, error) { // nolint: cyclop
+	images, err := l.currentServer().GetImages()
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := l.currentServer().GetImageAliases()
+	if err != nil {
+		return nil, err
+	}
+
+	// all containers of all projects are considered, not only cri containers
+	containers, err := l.allContainers()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImageReconcileReport{
+		DanglingAliases:  []string{},
+		UnusedImages:     []string{},
+		DuplicateAliases: map[string][]string{},
+	}
+
+	inUse := map[string]bool{}
+	for _, c := range containers {
+		inUse[c.Config[cfgVolatileBaseImage]] = true
+	}
+
+	exists := map[string]bool{}
+	for _, img := range images {
+		exists[img.Fingerprint] = true
+	}
+
+	byTarget := map[string][]string{}
+
+	for _, a := range aliases {
+		if !strings.HasPrefix(a.Name, lxeAliasPrefix) {
+			continue
+		}
+
+		if !exists[a.Target] {
+			report.DanglingAliases = append(report.DanglingAliases, a.Name)
+
+			continue
+		}
+
+		byTarget[a.Target] = append(byTarget[a.Target], a.Name)
+	}
+
+	for target, names := range byTarget {
+		if len(names) > 1 {
+			sort.Strings(names)
+			report.DuplicateAliases[target] = names
+		}
+	}
+
+	for _, img := range images {
+		if !l.IsCRI(img) || inUse[img.Fingerprint] || isPinnedImage(img) || !isOlderThan(img, opts.MinAge) {
+			continue
+		}
+
+		report.UnusedImages = append(report.UnusedImages, img.Fingerprint)
+	}
+
+	sort.Strings(report.DanglingAliases)
+	sort.Strings(report.UnusedImages)
+
+	if !opts.Cleanup {
+		return report, nil
+	}
+
+	var errs error
+
+	for _, alias := range report.DanglingAliases {
+		log.WithField("alias", alias).Info("removing dangling image alias")
+
+		err = l.currentServer().DeleteImageAlias(alias)
+		if err != nil && !IsNotFoundError(err) {
+			errs = errand.Append(errs, err)
+		}
+	}
+
+	for _, fingerprint := range report.UnusedImages {
+		log.WithFields(logrus.Fields{"fingerprint": fingerprint}).Info("removing unused image")
+
+		err = l.currentOpwait().DeleteImage(ctx, fingerprint)
+		if err != nil && !IsNotFoundError(err) {
+			errs = errand.Append(errs, err)
+		}
+	}
+
+	report.Removed = errs == nil
+
+	return report, errs
+}
+
+func isPinnedImage(img api.Image) bool {
+	pinned, _ := strconv.ParseBool(img.Properties[cfgImagePinned])
+
+	return pinned
+}
+
+// isOlderThan reports whether the image was uploaded and last used before minAge
+func isOlderThan(img api.Image, minAge time.Duration) bool {
+	last := img.UploadedAt
+	if img.LastUsedAt.After(last) {
+		last = img.LastUsedAt
+	}
+
+	return time.Since(last) >= minAge
+}
+
+// allContainers returns the containers of all projects using the images of the project of the connection
+func (l *client) allContainers() ([]api.Instance, error) {
+	projects, err := l.projects()
+	if err != nil {
+		return nil, err
+	}
+
+	containers := []api.Instance{}
+
+	for _, project := range projects {
+		cts, err := l.projectServer(project).GetInstances(api.InstanceTypeAny)
+		if err != nil {
+			return nil, err
+		}
+
+		containers = append(containers, cts...)
+	}
+
+	return containers, nil
+}
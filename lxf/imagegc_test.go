@@ -0,0 +1,78 @@
+package lxf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func testReconcileImages(fake *lxdfakes.FakeInstanceServer) {
+	old := time.Now().Add(-48 * time.Hour)
+
+	image := func(fingerprint string, uploadedAt time.Time, properties map[string]string) api.Image {
+		img := api.Image{Fingerprint: fingerprint, UploadedAt: uploadedAt}
+		img.Properties = properties
+
+		return img
+	}
+
+	fake.GetImagesReturns([]api.Image{
+		image("used", old, map[string]string{cfgIsCRI: "true"}),
+		image("unused", old, map[string]string{cfgIsCRI: "true"}),
+		image("pinned", old, map[string]string{cfgIsCRI: "true", cfgImagePinned: "true"}),
+		image("fresh", time.Now(), map[string]string{cfgIsCRI: "true"}),
+		image("foreign", old, map[string]string{}),
+	}, nil)
+
+	alias := func(name, target string) api.ImageAliasesEntry {
+		return api.ImageAliasesEntry{Name: name, ImageAliasesEntryPut: api.ImageAliasesEntryPut{Target: target}}
+	}
+
+	fake.GetImageAliasesReturns([]api.ImageAliasesEntry{
+		alias("lxe/images/ubuntu/jammy", "used"),
+		alias("lxe/images/ubuntu/22.04", "used"),
+		alias("lxe/images/alpine/edge", "unused"),
+		alias("lxe/images/gone", "deleted"),
+		alias("somethingelse", "deleted"),
+	}, nil)
+
+	fake.GetInstancesReturns([]api.Instance{
+		{InstancePut: api.InstancePut{Config: map[string]string{cfgVolatileBaseImage: "used"}}},
+	}, nil)
+}
+
+func TestClient_ReconcileImages_Report(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	testReconcileImages(fake)
+
+	report, err := c.ReconcileImages(context.Background(), ImageReconcileOptions{MinAge: time.Hour})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lxe/images/gone"}, report.DanglingAliases)
+	assert.Equal(t, []string{"unused"}, report.UnusedImages)
+	assert.Equal(t, map[string][]string{"used": {"lxe/images/ubuntu/22.04", "lxe/images/ubuntu/jammy"}}, report.DuplicateAliases)
+	assert.False(t, report.Removed)
+	assert.Equal(t, 0, fake.DeleteImageAliasCallCount())
+	assert.Equal(t, 0, fake.DeleteImageCallCount())
+}
+
+func TestClient_ReconcileImages_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	testReconcileImages(fake)
+	fake.DeleteImageReturns(&lxdfakes.FakeOperation{}, nil)
+
+	report, err := c.ReconcileImages(context.Background(), ImageReconcileOptions{Cleanup: true, MinAge: time.Hour})
+	assert.NoError(t, err)
+	assert.True(t, report.Removed)
+	assert.Equal(t, 1, fake.DeleteImageAliasCallCount())
+	assert.Equal(t, "lxe/images/gone", fake.DeleteImageAliasArgsForCall(0))
+	assert.Equal(t, 1, fake.DeleteImageCallCount())
+	assert.Equal(t, "unused", fake.DeleteImageArgsForCall(0))
+}
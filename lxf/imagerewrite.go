@@ -0,0 +1,107 @@
+package lxf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lxc/lxd/shared/osarch"
+)
+
+const imageRewriteWildcard = "*"
+
+var (
+	ErrInvalidRewriteRule = errors.New("invalid image rewrite rule")
+)
+
+// ImageRewriteRule maps a requested image to other images, possibly on other remotes. Match is either an exact image
+// name like `images:ubuntu/jammy` or a prefix ending with a wildcard like `images:ubuntu/*`. A lone wildcard matches
+// every image. In Targets a wildcard is replaced by the part of the requested image the wildcard in Match has matched.
+// Targets are tried in the given order until one can be pulled. If Architecture is set, the targets are pulled for that
+// architecture instead of the one of the node.
+type ImageRewriteRule struct {
+	Match        string
+	Targets      []string
+	Architecture string
+}
+
+// ImageRewriteRules is an ordered list of rewrite rules. The first matching rule wins.
+type ImageRewriteRules []ImageRewriteRule
+
+// Validate checks whether all rules are well formed
+func (r ImageRewriteRules) Validate() error {
+	for i, rule := range r {
+		if rule.Match == "" {
+			return fmt.Errorf("%w: rule %d has no match", ErrInvalidRewriteRule, i)
+		}
+
+		if strings.Count(rule.Match, imageRewriteWildcard) > 1 || (strings.Contains(rule.Match, imageRewriteWildcard) && !strings.HasSuffix(rule.Match, imageRewriteWildcard)) {
+			return fmt.Errorf("%w: rule %d may only have a wildcard at the end of match '%s'", ErrInvalidRewriteRule, i, rule.Match)
+		}
+
+		if len(rule.Targets) == 0 {
+			return fmt.Errorf("%w: rule %d has no targets", ErrInvalidRewriteRule, i)
+		}
+
+		if rule.Architecture != "" {
+			_, err := osarch.ArchitectureId(rule.Architecture)
+			if err != nil {
+				return fmt.Errorf("%w: rule %d has unknown architecture '%s'", ErrInvalidRewriteRule, i, rule.Architecture)
+			}
+		}
+
+		for _, target := range rule.Targets {
+			if strings.Count(target, imageRewriteWildcard) > 1 {
+				return fmt.Errorf("%w: rule %d may only have one wildcard in target '%s'", ErrInvalidRewriteRule, i, target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Rewrite returns the ordered list of images to try for the requested image. If no rule matches, the requested image
+// is the only entry.
+func (r ImageRewriteRules) Rewrite(image string) []string {
+	for _, rule := range r {
+		wildcard, matches := rule.match(image)
+		if !matches {
+			continue
+		}
+
+		targets := make([]string, 0, len(rule.Targets))
+		for _, target := range rule.Targets {
+			targets = append(targets, strings.Replace(target, imageRewriteWildcard, wildcard, 1))
+		}
+
+		return targets
+	}
+
+	return []string{image}
+}
+
+// Architecture returns the architecture the first matching rule requests for the image, empty if the node's
+// architecture should be used
+func (r ImageRewriteRules) Architecture(image string) string {
+	for _, rule := range r {
+		if _, matches := rule.match(image); matches {
+			return rule.Architecture
+		}
+	}
+
+	return ""
+}
+
+// match reports whether the rule matches the image and which part the wildcard matched
+func (r ImageRewriteRule) match(image string) (string, bool) {
+	if !strings.HasSuffix(r.Match, imageRewriteWildcard) {
+		return "", r.Match == image
+	}
+
+	prefix := strings.TrimSuffix(r.Match, imageRewriteWildcard)
+	if !strings.HasPrefix(image, prefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(image, prefix), true
+}
@@ -0,0 +1,107 @@
+package lxf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRewriteRules_Rewrite(t *testing.T) {
+	t.Parallel()
+
+	rules := ImageRewriteRules{
+		{Match: "images:ubuntu/jammy", Targets: []string{"internal-mirror:ubuntu/22.04"}},
+		{Match: "images:ubuntu/*", Targets: []string{"internal-mirror:ubuntu/*", "images:ubuntu/*"}},
+		{Match: "images:*", Targets: []string{"internal-mirror:static"}},
+	}
+
+	tests := []struct {
+		image string
+		want  []string
+	}{
+		{"images:ubuntu/jammy", []string{"internal-mirror:ubuntu/22.04"}},
+		{"images:ubuntu/focal/cloud", []string{"internal-mirror:ubuntu/focal/cloud", "images:ubuntu/focal/cloud"}},
+		{"images:alpine/edge", []string{"internal-mirror:static"}},
+		{"ubuntu:jammy", []string{"ubuntu:jammy"}},
+		{"local:ubuntu/jammy", []string{"local:ubuntu/jammy"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.image, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, rules.Rewrite(tt.image))
+		})
+	}
+}
+
+func TestImageRewriteRules_Rewrite_Empty(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"images:ubuntu/jammy"}, ImageRewriteRules(nil).Rewrite("images:ubuntu/jammy"))
+}
+
+func TestImageRewriteRules_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rules ImageRewriteRules
+		valid bool
+	}{
+		{"exact", ImageRewriteRules{{Match: "images:ubuntu/jammy", Targets: []string{"local:jammy"}}}, true},
+		{"wildcard", ImageRewriteRules{{Match: "images:*", Targets: []string{"mirror:*"}}}, true},
+		{"catchall", ImageRewriteRules{{Match: "*", Targets: []string{"images:alpine/edge"}}}, true},
+		{"nomatch", ImageRewriteRules{{Targets: []string{"local:jammy"}}}, false},
+		{"notargets", ImageRewriteRules{{Match: "images:*"}}, false},
+		{"middlewildcard", ImageRewriteRules{{Match: "images:*/jammy", Targets: []string{"local:jammy"}}}, false},
+		{"multiwildcard", ImageRewriteRules{{Match: "images:*", Targets: []string{"mirror:*/*"}}}, false},
+		{"architecture", ImageRewriteRules{{Match: "images:*", Targets: []string{"mirror:*"}, Architecture: "aarch64"}}, true},
+		{"unknownarchitecture", ImageRewriteRules{{Match: "images:*", Targets: []string{"mirror:*"}, Architecture: "z80"}}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.rules.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidRewriteRule)
+			}
+		})
+	}
+}
+
+func TestClient_SetImageRewriteRules_Invalid(t *testing.T) {
+	t.Parallel()
+
+	client, _ := testClient()
+
+	err := client.SetImageRewriteRules(ImageRewriteRules{{Match: "images:*"}})
+	assert.Error(t, err)
+	assert.Empty(t, client.rewriteRules)
+}
+
+func TestClient_imageRewriteRules_CRITest(t *testing.T) {
+	t.Parallel()
+
+	client, _ := testClient()
+	client.config.DefaultRemote = "local"
+	client.critestMode = true
+
+	err := client.SetImageRewriteRules(ImageRewriteRules{{Match: "images:ubuntu/*", Targets: []string{"mirror:ubuntu/*"}}})
+	assert.NoError(t, err)
+
+	rules := client.imageRewriteRules()
+
+	assert.Equal(t, []string{"mirror:ubuntu/jammy"}, rules.Rewrite("images:ubuntu/jammy"))
+	assert.Equal(t, []string{"local:critest/default"}, rules.Rewrite("local:critest/default"))
+	assert.Equal(t, []string{critestDefaultImageSource}, rules.Rewrite("gcr.io:k8s-staging-cri-tools/test-image-2"))
+	assert.Equal(t, "aarch64", rules.Architecture("gcr.io:k8s-staging-cri-tools/test-image-2"))
+	assert.Equal(t, "", rules.Architecture("busybox"))
+	assert.Equal(t, []string{critestDefaultImageSource}, rules.Rewrite("busybox"))
+}
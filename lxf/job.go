@@ -0,0 +1,200 @@
+package lxf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	cfgJob        = "user.job"
+	cfgCommand    = "user.command"
+	cfgWorkingDir = "user.working_dir"
+	cfgExitCode   = "user.exit_code"
+	cfgStdin      = "user.stdin"
+	cfgTTY        = "user.tty"
+	// cfgJobOperation is the id of the exec operation of a running job
+	cfgJobOperation = "user.job_operation"
+)
+
+var (
+	ErrNotAttachable = errors.New("only interactive job containers with a command can be attached")
+
+	// CodeJobTerminated is the exit code of a job which was stopped before its command exited
+	CodeJobTerminated = CodeExecError + int32(unix.SIGTERM)
+	// JobStopTimeout is the time in seconds the instance of a finished job gets to shut down
+	JobStopTimeout = 10
+)
+
+// runJob executes the command of the job container and stops the instance when it exits, recording its exit code. It
+// runs until the command exits and is therefore not bound to a request.
+func (c *Container) runJob(ctx context.Context) {
+	log := c.log(ctx).WithField("cmd", c.Command)
+	log.Info("job started")
+
+	code, err := c.execJob(ctx)
+	if err != nil {
+		log.WithError(err).Error("job failed")
+
+		code = CodeExecError
+	}
+
+	c.endJob(ctx, code)
+}
+
+// AttachJob executes the command of an interactive job container with the streams attached. When the command exits,
+// the job ends like other jobs and its exit code is returned.
+func (c *Container) AttachJob(ctx context.Context, stdin io.ReadCloser, stdout, stderr io.WriteCloser, resize <-chan remotecommand.TerminalSize) (int32, error) {
+	if !c.Job || !c.Interactive || len(c.Command) == 0 {
+		return CodeExecError, ErrNotAttachable
+	}
+
+	log := c.log(ctx).WithField("cmd", c.Command)
+	log.Info("job attached")
+
+	code, err := c.client.Exec(ctx, c.ID, c.Command, stdin, stdout, stderr, true, c.TTY, 0, resize)
+	if err != nil {
+		log.WithError(err).Error("job failed")
+
+		code = CodeExecError
+	}
+
+	c.endJob(ctx, code)
+
+	return code, err
+}
+
+// endJob records the exit code of the command and stops the instance
+func (c *Container) endJob(ctx context.Context, code int32) {
+	log := c.log(ctx).WithFields(logrus.Fields{"cmd": c.Command, "exit": code})
+
+	err := c.Update(ctx, func(c *Container) error {
+		c.finishJob(code)
+
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Error("unable to record exit code of job")
+	}
+
+	err = c.Stop(ctx, JobStopTimeout)
+	if err != nil {
+		log.WithError(err).Error("unable to stop finished job")
+
+		return
+	}
+
+	log.Info("job finished")
+}
+
+// execJob executes the command and waits till it exits. The output isn't recorded. The operation is recorded, so the
+// job can be waited for again if LXE is restarted in the meantime.
+func (c *Container) execJob(ctx context.Context) (int32, error) {
+	op, err := c.client.projectServer(c.project).ExecInstance(c.ID, api.InstanceExecPost{
+		Command: c.Command,
+		Cwd:     c.WorkingDir,
+	}, nil)
+	if err != nil {
+		return CodeExecError, err
+	}
+
+	id := op.Get().ID
+
+	err = c.Update(ctx, func(c *Container) error {
+		c.JobOperation = id
+
+		return nil
+	})
+	if err != nil {
+		c.log(ctx).WithError(err).Warn("unable to record operation of job, it's lost if LXE restarts")
+	}
+
+	err = op.Wait()
+	if err != nil {
+		return CodeExecError, err
+	}
+
+	return jobExitCode(op.Get())
+}
+
+// ResumeJob waits again in the background for the command of a job which was running when LXE stopped, as the job
+// is only watched in memory. If LXD doesn't know the operation of the command anymore, like after LXD was restarted,
+// its exit code is lost and the job ends with CodeExecError. A job without a recorded operation never ran its command,
+// like when the instance was started without LXE, so the command is run. It reports whether a job was resumed.
+func (c *Container) ResumeJob(ctx context.Context) bool {
+	if !c.Job || c.Interactive || len(c.Command) == 0 || c.StateName != ContainerStateRunning || !c.StartedAt.After(c.FinishedAt) {
+		return false
+	}
+
+	job := *c
+
+	if c.JobOperation == "" {
+		go job.runJob(context.Background())
+	} else {
+		go job.resumeJob(context.Background())
+	}
+
+	return true
+}
+
+// resumeJob waits for the recorded operation of the job and ends the job when it's done
+func (c *Container) resumeJob(ctx context.Context) {
+	log := c.log(ctx).WithFields(logrus.Fields{"cmd": c.Command, "operation": c.JobOperation})
+	log.Info("job resumed")
+
+	code, err := c.waitJob()
+	if err != nil {
+		log.WithError(err).Error("unable to wait for job")
+
+		code = CodeExecError
+	}
+
+	c.endJob(ctx, code)
+}
+
+// waitJob waits till the recorded operation of the job is done and returns its exit code
+func (c *Container) waitJob() (int32, error) {
+	if c.JobOperation == "" {
+		return CodeExecError, fmt.Errorf("%w: operation of job not recorded", ErrNotFound)
+	}
+
+	// a timeout of -1 waits until the operation is done
+	op, _, err := c.client.projectServer(c.project).GetOperationWait(c.JobOperation, -1)
+	if err != nil {
+		return CodeExecError, err
+	}
+
+	if op.StatusCode != api.Success {
+		return CodeExecError, fmt.Errorf("operation %s: %s", op.Status, op.Err) // nolint: goerr113
+	}
+
+	return jobExitCode(*op)
+}
+
+// jobExitCode returns the exit code of the command of the exec operation
+func jobExitCode(op api.Operation) (int32, error) {
+	code, ok := op.Metadata["return"].(float64)
+	if !ok {
+		return CodeExecError, fmt.Errorf("code %w: %#v", ErrParse, op.Metadata["return"])
+	}
+
+	return int32(code), nil
+}
+
+// finishJob records the exit of the job, unless it was already recorded since the container was started
+func (c *Container) finishJob(code int32) {
+	if c.FinishedAt.After(c.StartedAt) {
+		return
+	}
+
+	c.ExitCode = code
+	c.FinishedAt = time.Now()
+	c.JobOperation = ""
+}
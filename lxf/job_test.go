@@ -0,0 +1,267 @@
+package lxf
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// jobClient returns a client whose fake server serves job containers of the sandbox foo and accepts their updates
+func jobClient() (*client, *lxdfakes.FakeInstanceServer) {
+	client, fake := testClient()
+
+	fake.GetInstanceStub = func(name string) (*api.Instance, string, error) {
+		ct := basicContainer(name, "foo")
+		ct.Config[cfgJob] = "true"
+		ct.Config[cfgCommand] = `["make"]`
+
+		return ct, "etag", nil
+	}
+	fake.GetInstanceStateReturns(&api.InstanceState{}, "", nil)
+	fake.GetProfileReturns(basicProfile("foo"), "etag", nil)
+	fake.UpdateInstanceReturns(&lxdfakes.FakeOperation{}, nil)
+
+	return client, fake
+}
+
+func TestContainer_execJob(t *testing.T) {
+	t.Parallel()
+
+	client, fake := jobClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.ExecInstanceReturns(fakeOp, nil)
+	fakeOp.GetReturns(api.Operation{
+		ID: "op1",
+		Metadata: map[string]interface{}{
+			"return": float64(3),
+		},
+	})
+
+	c := client.NewContainer("foo")
+	c.ID = "bar"
+	c.Command = []string{"make", "test"}
+	c.WorkingDir = "/src"
+
+	code, err := c.execJob(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), code)
+
+	id, req, args := fake.ExecInstanceArgsForCall(0)
+	assert.Equal(t, "bar", id)
+	assert.Equal(t, []string{"make", "test"}, req.Command)
+	assert.Equal(t, "/src", req.Cwd)
+	assert.False(t, req.WaitForWS)
+	assert.Nil(t, args)
+
+	// the operation is recorded, so the job can be resumed
+	assert.Equal(t, 1, fake.UpdateInstanceCallCount())
+
+	_, put, _ := fake.UpdateInstanceArgsForCall(0)
+	assert.Equal(t, "op1", put.Config[cfgJobOperation])
+}
+
+func TestContainer_execJob_Error(t *testing.T) {
+	t.Parallel()
+
+	client, fake := testClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.ExecInstanceReturns(fakeOp, nil)
+	fake.GetInstanceReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Instance not found"))
+	fakeOp.WaitReturns(errors.New("instance is not running"))
+
+	c := client.NewContainer("foo")
+	c.Command = []string{"true"}
+
+	code, err := c.execJob(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, CodeExecError, code)
+}
+
+func TestContainer_finishJob(t *testing.T) {
+	t.Parallel()
+
+	started := time.Now().Add(-time.Minute)
+
+	c := &Container{StartedAt: started}
+	c.JobOperation = "op1"
+	c.finishJob(1)
+	assert.Equal(t, int32(1), c.ExitCode)
+	assert.Empty(t, c.JobOperation)
+	assert.True(t, c.FinishedAt.After(started))
+
+	// the first recorded exit is kept
+	finished := c.FinishedAt
+	c.finishJob(CodeJobTerminated)
+	assert.Equal(t, int32(1), c.ExitCode)
+	assert.Equal(t, finished, c.FinishedAt)
+}
+
+func TestClient_GetContainer_Job(t *testing.T) {
+	t.Parallel()
+
+	client, fake := testClient()
+
+	c := client.NewContainer("foo")
+	c.Job = true
+	c.Command = []string{"sh", "-c", "exit 2"}
+	c.WorkingDir = "/tmp"
+	c.ExitCode = 2
+	c.JobOperation = "op1"
+	c.Interactive = true
+	c.TTY = true
+
+	ct := basicContainer("bar", "foo")
+	ct.Config = makeContainerConfig(c)
+	fake.GetInstanceReturns(ct, "", nil)
+
+	r, err := client.GetContainer(context.Background(), "bar")
+	assert.NoError(t, err)
+	assert.True(t, r.Job)
+	assert.Equal(t, c.Command, r.Command)
+	assert.Equal(t, "/tmp", r.WorkingDir)
+	assert.Equal(t, int32(2), r.ExitCode)
+	assert.Equal(t, "op1", r.JobOperation)
+	assert.True(t, r.Interactive)
+	assert.True(t, r.TTY)
+	assert.NotContains(t, r.Config, cfgExitCode)
+}
+
+func TestContainer_AttachJob_NotInteractive(t *testing.T) {
+	t.Parallel()
+
+	client, fake := testClient()
+
+	c := client.NewContainer("foo")
+	c.Job = true
+	c.Command = []string{"sh"}
+
+	_, err := c.AttachJob(context.Background(), nil, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrNotAttachable)
+	assert.Equal(t, 0, fake.ExecInstanceCallCount())
+}
+
+func TestContainer_waitJob(t *testing.T) {
+	t.Parallel()
+
+	client, fake := testClient()
+	fake.GetOperationWaitReturns(&api.Operation{
+		StatusCode: api.Success,
+		Metadata: map[string]interface{}{
+			"return": float64(4),
+		},
+	}, "", nil)
+
+	c := client.NewContainer("foo")
+	c.JobOperation = "op1"
+
+	code, err := c.waitJob()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), code)
+
+	id, timeout := fake.GetOperationWaitArgsForCall(0)
+	assert.Equal(t, "op1", id)
+	assert.Equal(t, -1, timeout)
+}
+
+func TestContainer_waitJob_Lost(t *testing.T) {
+	t.Parallel()
+
+	client, fake := testClient()
+	fake.GetOperationWaitReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Operation not found"))
+
+	c := client.NewContainer("foo")
+
+	// the operation wasn't recorded
+	code, err := c.waitJob()
+	assert.True(t, IsNotFoundError(err))
+	assert.Equal(t, CodeExecError, code)
+	assert.Equal(t, 0, fake.GetOperationWaitCallCount())
+
+	// LXD doesn't know the operation anymore
+	c.JobOperation = "op1"
+	code, err = c.waitJob()
+	assert.True(t, IsNotFoundError(err))
+	assert.Equal(t, CodeExecError, code)
+}
+
+func TestContainer_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	client, fake := jobClient()
+	fake.GetOperationWaitReturns(&api.Operation{
+		StatusCode: api.Success,
+		Metadata: map[string]interface{}{
+			"return": float64(4),
+		},
+	}, "", nil)
+
+	op := &lxdfakes.FakeOperation{}
+	fake.UpdateInstanceStateReturns(op, nil)
+
+	running := func() *Container {
+		c := client.NewContainer("foo")
+		c.ID = "bar"
+		c.Job = true
+		c.Command = []string{"make"}
+		c.JobOperation = "op1"
+		c.StateName = ContainerStateRunning
+		c.StartedAt = time.Now()
+
+		return c
+	}
+
+	notResumed := []func(c *Container){
+		func(c *Container) { c.Job = false },
+		func(c *Container) { c.Interactive = true },
+		func(c *Container) { c.StateName = ContainerStateExited },
+		func(c *Container) { c.FinishedAt = c.StartedAt.Add(time.Second) },
+	}
+	for _, mutate := range notResumed {
+		c := running()
+		mutate(c)
+		assert.False(t, c.ResumeJob(context.Background()))
+	}
+
+	assert.True(t, running().ResumeJob(context.Background()))
+
+	// the job ends with the exit code of the operation and the instance is stopped
+	assert.Eventually(t, func() bool {
+		return fake.UpdateInstanceStateCallCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, put, _ := fake.UpdateInstanceArgsForCall(0)
+	assert.Equal(t, "4", put.Config[cfgExitCode])
+	assert.Empty(t, put.Config[cfgJobOperation])
+}
+
+func TestContainer_ResumeJob_NotRun(t *testing.T) {
+	t.Parallel()
+
+	client, fake := jobClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+	fake.ExecInstanceReturns(fakeOp, nil)
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+
+	c := client.NewContainer("foo")
+	c.ID = "bar"
+	c.Job = true
+	c.Command = []string{"make"}
+	c.StateName = ContainerStateRunning
+	c.StartedAt = time.Now()
+
+	// without a recorded operation the command never ran
+	assert.True(t, c.ResumeJob(context.Background()))
+
+	assert.Eventually(t, func() bool {
+		return fake.ExecInstanceCallCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, fake.GetOperationWaitCallCount())
+}
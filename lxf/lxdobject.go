@@ -6,6 +6,8 @@ import "github.com/automaticserver/lxe/lxf/device"
 type LXDObject struct {
 	// client holds the lxf.Client representing as a lxd client
 	client *client
+	// project the object lives in, empty for the project of the connection
+	project string
 	// ID is a unique generated ID and is read-only
 	ID string
 	// ETag uniquely identifies user modifiable content of this resource, prevents race conditions when saving
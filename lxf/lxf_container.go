@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -10,7 +11,6 @@ import (
 	"github.com/automaticserver/lxe/lxf/device"
 	"github.com/lxc/lxd/shared/api"
 	opencontainers "github.com/opencontainers/runtime-spec/specs-go"
-	"github.com/sirupsen/logrus"
 )
 
 // NewContainer creates a local representation of a container
@@ -21,13 +21,19 @@ func (l *client) NewContainer(sandboxID string, additionalProfiles ...string) *C
 	c.Profiles = append(c.Profiles, sandboxID)
 	c.Config = make(map[string]string)
 	c.Environment = make(map[string]string)
+	c.Type = InstanceTypeContainer
 
 	return c
 }
 
 // GetContainer returns the container identified by id
-func (l *client) GetContainer(id string) (*Container, error) {
-	ct, ETag, err := l.server.GetContainer(id)
+func (l *client) GetContainer(ctx context.Context, id string) (*Container, error) {
+	project, err := l.findProject(id)
+	if err != nil {
+		return nil, err
+	}
+
+	ct, ETag, err := l.getContainer(project, id)
 	if err != nil {
 		return nil, err
 	}
@@ -36,43 +42,87 @@ func (l *client) GetContainer(id string) (*Container, error) {
 		return nil, ErrNotFound
 	}
 
-	return l.toContainer(ct, ETag)
+	c, err := l.toContainer(ct, ETag)
+	if err != nil {
+		return nil, err
+	}
+
+	c.project = project
+
+	return c, nil
 }
 
 // ListContainers returns a list of all available containers
-func (l *client) ListContainers() ([]*Container, error) {
+func (l *client) ListContainers(ctx context.Context) ([]*Container, error) {
 	var (
 		err  error
 		etag string
 	)
 
-	cts, err := l.server.GetContainers()
+	projects, err := l.projects()
 	if err != nil {
 		return nil, err
 	}
 
 	var cl = []*Container{}
 
-	for _, ct := range cts {
-		ct := ct // pin!
-
-		if !l.IsCRI(ct) {
-			continue
-		}
-
-		c, err := l.toContainer(&ct, etag)
+	for _, project := range projects {
+		cts, err := l.listContainers(project)
 		if err != nil {
 			return nil, err
 		}
 
-		cl = append(cl, c)
+		for _, ct := range cts {
+			ct := ct // pin!
+
+			if !l.IsCRI(ct) {
+				continue
+			}
+
+			c, err := l.toContainer(&ct, etag)
+			if err != nil {
+				return nil, err
+			}
+
+			c.project = project
+			l.indexObject(c.ID, project)
+
+			cl = append(cl, c)
+		}
 	}
 
 	return cl, nil
 }
 
+// getContainer returns the container from the cache if possible
+func (l *client) getContainer(project, name string) (*api.Instance, string, error) {
+	if ct, etag, ok := l.cache.getContainer(project, name); ok {
+		return &ct, etag, nil
+	}
+
+	return l.fetchContainer(project, name)
+}
+
+// listContainers returns the containers of the project from the cache if possible
+func (l *client) listContainers(project string) ([]api.Instance, error) {
+	if !l.cache.isSynced() {
+		return l.projectServer(project).GetInstances(api.InstanceTypeAny)
+	}
+
+	keys, all := l.cache.listContainers()
+	cts := []api.Instance{}
+
+	for i, k := range keys {
+		if k.project == project {
+			cts = append(cts, all[i])
+		}
+	}
+
+	return cts, nil
+}
+
 // toContainer will convert an lxd container to lxf format
-func (l *client) toContainer(ct *api.Container, etag string) (*Container, error) { // nolint: gocognit, cyclop
+func (l *client) toContainer(ct *api.Instance, etag string) (*Container, error) { // nolint: gocognit, cyclop
 	var err error
 
 	var attempt uint64
@@ -120,6 +170,7 @@ func (l *client) toContainer(ct *api.Container, etag string) (*Container, error)
 
 	c.ID = ct.Name
 	c.ETag = etag
+	c.Type = InstanceType(ct.Type)
 	c.Image = ct.Config[cfgVolatileBaseImage]
 	c.Metadata = ContainerMetadata{
 		Name:    ct.Config[cfgMetaName],
@@ -135,6 +186,28 @@ func (l *client) toContainer(ct *api.Container, etag string) (*Container, error)
 	c.FinishedAt = time.Unix(0, finishedAt)
 
 	c.Environment = extractEnvVars(ct.Config)
+
+	c.Job = ct.Config[cfgJob] == strconv.FormatBool(true)
+	c.WorkingDir = ct.Config[cfgWorkingDir]
+	c.JobOperation = ct.Config[cfgJobOperation]
+	c.Interactive = ct.Config[cfgStdin] == strconv.FormatBool(true)
+	c.TTY = ct.Config[cfgTTY] == strconv.FormatBool(true)
+
+	if cmd := ct.Config[cfgCommand]; cmd != "" {
+		err = json.Unmarshal([]byte(cmd), &c.Command)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if codeS := ct.Config[cfgExitCode]; codeS != "" {
+		code, err := strconv.ParseInt(codeS, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		c.ExitCode = int32(code)
+	}
 	c.Privileged = privileged
 	c.CloudInitUserData = ct.Config[cfgCloudInitUserData]
 	c.CloudInitMetaData = ct.Config[cfgCloudInitMetaData]
@@ -227,18 +300,18 @@ func (l *client) toContainer(ct *api.Container, etag string) (*Container, error)
 	return c, nil
 }
 
+// EventHandler is called on container state changes. The context is not bound to any request. The changes of a
+// container are passed one after another.
 type EventHandler interface {
-	ContainerStarted(c *Container) error
-	ContainerStopped(c *Container) error
+	ContainerStarted(ctx context.Context, c *Container) error
+	ContainerStopped(ctx context.Context, c *Container) error
 }
 
-// lifecycleEventHandler is registered to the lxd event handler for listening to container start events
-func (l *client) lifecycleEventHandler(event api.Event) { // nolint: cyclop
-	log := log
-
+// lifecycleEventHandler is registered to the lxd event handler for listening to container lifecycle events. It only
+// updates the cache and publishes the container events, so a slow subscriber doesn't hold up receiving further events.
+func (l *client) lifecycleEventHandler(event api.Event) {
 	// we should always only get lifecycle events due to the handler setup but just in case ...
 	if event.Type != api.EventTypeLifecycle {
-		// If the started container is not a cri container, we also get "not found", so this container can be ignored
 		return
 	}
 
@@ -251,54 +324,26 @@ func (l *client) lifecycleEventHandler(event api.Event) { // nolint: cyclop
 		return
 	}
 
-	// Early exit. We are only interested in container started, stopped and restarted events
-	if eventLifecycle.Action != api.EventLifecycleInstanceStarted && eventLifecycle.Action != api.EventLifecycleInstanceStopped && eventLifecycle.Action != api.EventLifecycleInstanceRestarted {
-		return
-	}
-
-	containerID := GetContainerIDFromSelflink(eventLifecycle.Source)
-
-	log = log.WithFields(logrus.Fields{
-		"event":       eventLifecycle.Action,
-		"containerid": containerID,
-	})
-	log.Info("event detected")
-
-	c, err := l.GetContainer(containerID)
-	if err != nil {
-		log.WithError(err).Error("unable to find container")
-
-		// return immediately since we can't do anything when we get an error here
+	// Events of all projects are received with namespace projects, only the ones of LXE are of interest
+	if l.conn.NamespaceProjects.Enabled && !l.conn.NamespaceProjects.isNamespaceProject(event.Project) &&
+		event.Project != "" && event.Project != defaultProject {
 		return
 	}
 
-	startedFn := func() {
-		err := l.eventHandler.ContainerStarted(c)
-		if err != nil {
-			log.WithError(err).Error("event handler failed")
-		}
-	}
+	// The cache is updated first, so the subscribers see the changed container
+	l.updateCache(event.Project, eventLifecycle)
 
-	stoppedFn := func() {
-		err := l.eventHandler.ContainerStopped(c)
-		if err != nil {
-			log.WithError(err).Error("event handler failed")
+	for _, ce := range containerEvents(eventLifecycle, event.Timestamp) {
+		if ce.Action != ContainerDeleted && l.conn.NamespaceProjects.isNamespaceProject(event.Project) {
+			l.indexObject(ce.ContainerID, event.Project)
 		}
-	}
 
-	switch eventLifecycle.Action {
-	case api.EventLifecycleInstanceStarted:
-		startedFn()
-	case api.EventLifecycleInstanceStopped:
-		stoppedFn()
-	case api.EventLifecycleInstanceRestarted:
-		stoppedFn()
-		startedFn()
+		l.events.publish(ce)
 	}
 }
 
 // ContainerSelflinkRegex to extract the containername in selflinks.
-var ContainerSelflinkRegex = regexp.MustCompile(`^/[\d.]+/(instances|containers)/(.*)(\?.*)?$`)
+var ContainerSelflinkRegex = regexp.MustCompile(`^/[\d.]+/(instances|containers)/([^?]*)(\?.*)?$`)
 
 // ContainerSelflinkMatch-es which index of the match contains the containername
 const ContainerSelflinkMatch = 2
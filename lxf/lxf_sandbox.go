@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -22,8 +23,13 @@ func (l *client) NewSandbox() *Sandbox {
 }
 
 // GetSandbox will find a sandbox by id and return it.
-func (l *client) GetSandbox(id string) (*Sandbox, error) {
-	p, ETag, err := l.server.GetProfile(id)
+func (l *client) GetSandbox(ctx context.Context, id string) (*Sandbox, error) {
+	project, err := l.findProject(id)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ETag, err := l.getProfile(project, id)
 	if err != nil {
 		return nil, err
 	}
@@ -32,32 +38,49 @@ func (l *client) GetSandbox(id string) (*Sandbox, error) {
 		return nil, ErrNotFound
 	}
 
-	return l.toSandbox(p, ETag)
+	s, err := l.toSandbox(p, ETag)
+	if err != nil {
+		return nil, err
+	}
+
+	s.project = project
+
+	return s, nil
 }
 
 // ListSandboxes will return a list with all the available sandboxes
-func (l *client) ListSandboxes() ([]*Sandbox, error) {
+func (l *client) ListSandboxes(ctx context.Context) ([]*Sandbox, error) {
 	var ETag string
 
-	ps, err := l.server.GetProfiles()
+	projects, err := l.projects()
 	if err != nil {
 		return nil, err
 	}
 
 	var sl = []*Sandbox{}
 
-	for _, p := range ps {
-		p := p // pin!
-		if !l.IsCRI(p) {
-			continue
-		}
-
-		s, err := l.toSandbox(&p, ETag)
+	for _, project := range projects {
+		ps, err := l.listProfiles(project)
 		if err != nil {
 			return nil, err
 		}
 
-		sl = append(sl, s)
+		for _, p := range ps {
+			p := p // pin!
+			if !l.IsCRI(p) {
+				continue
+			}
+
+			s, err := l.toSandbox(&p, ETag)
+			if err != nil {
+				return nil, err
+			}
+
+			s.project = project
+			l.indexObject(s.ID, project)
+
+			sl = append(sl, s)
+		}
 	}
 
 	return sl, nil
@@ -90,6 +113,7 @@ func (l *client) toSandbox(p *api.Profile, etag string) (*Sandbox, error) {
 	s.ETag = etag
 	s.Hostname = p.Config[cfgHostname]
 	s.LogDirectory = p.Config[cfgLogDirectory]
+	s.RuntimeHandler = p.Config[cfgRuntimeHandler]
 	s.Metadata = SandboxMetadata{
 		Attempt:   uint32(attempt),
 		Name:      p.Config[cfgMetaName],
@@ -136,3 +160,30 @@ func (l *client) toSandbox(p *api.Profile, etag string) (*Sandbox, error) {
 
 	return s, nil
 }
+
+// getProfile returns the profile from the cache if possible
+func (l *client) getProfile(project, name string) (*api.Profile, string, error) {
+	if p, etag, ok := l.cache.getProfile(project, name); ok {
+		return &p, etag, nil
+	}
+
+	return l.fetchProfile(project, name)
+}
+
+// listProfiles returns the profiles of the project from the cache if possible
+func (l *client) listProfiles(project string) ([]api.Profile, error) {
+	if !l.cache.isSynced() {
+		return l.projectServer(project).GetProfiles()
+	}
+
+	keys, all := l.cache.listProfiles()
+	ps := []api.Profile{}
+
+	for i, k := range keys {
+		if k.project == project {
+			ps = append(ps, all[i])
+		}
+	}
+
+	return ps, nil
+}
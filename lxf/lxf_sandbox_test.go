@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"strconv"
 	"testing"
 	"time"
@@ -41,7 +42,7 @@ func TestClient_GetSandbox_Minimal(t *testing.T) {
 
 	fake.GetProfileReturns(basicProfile("foo"), "", nil)
 
-	s, err := client.GetSandbox("foo")
+	s, err := client.GetSandbox(context.Background(), "foo")
 	assert.NoError(t, err)
 	assert.Equal(t, "foo", s.ID)
 	assert.Equal(t, "foo", fake.GetProfileArgsForCall(0))
@@ -55,7 +56,7 @@ func TestClient_GetSandbox_Missing(t *testing.T) {
 
 	fake.GetProfileReturns(nil, "", ErrNotFound)
 
-	s, err := client.GetSandbox("foo")
+	s, err := client.GetSandbox(context.Background(), "foo")
 
 	var expected *Sandbox
 
@@ -71,7 +72,7 @@ func TestClient_GetSandbox_NonCri(t *testing.T) {
 
 	fake.GetProfileReturns(&api.Profile{}, "", nil)
 
-	s, err := client.GetSandbox("foo")
+	s, err := client.GetSandbox(context.Background(), "foo")
 
 	var expected *Sandbox
 
@@ -87,7 +88,7 @@ func TestClient_ListSandboxes_Minimal(t *testing.T) {
 
 	fake.GetProfilesReturns([]api.Profile{*basicProfile("foo"), *basicProfile("bar")}, nil)
 
-	sl, err := client.ListSandboxes()
+	sl, err := client.ListSandboxes(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, sl, 2)
 	assert.Equal(t, 1, fake.GetProfilesCallCount())
@@ -100,7 +101,7 @@ func TestClient_ListSandboxes_Error(t *testing.T) {
 
 	fake.GetProfilesReturns([]api.Profile{*basicProfile("foo"), *basicProfile("bar")}, ErrNotFound)
 
-	sl, err := client.ListSandboxes()
+	sl, err := client.ListSandboxes(context.Background())
 	assert.Error(t, err)
 	assert.Len(t, sl, 0)
 	assert.Equal(t, 1, fake.GetProfilesCallCount())
@@ -113,7 +114,7 @@ func TestClient_ListSandboxes_Missing(t *testing.T) {
 
 	fake.GetProfilesReturns([]api.Profile{}, nil)
 
-	sl, err := client.ListSandboxes()
+	sl, err := client.ListSandboxes(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, sl, 0)
 	assert.Equal(t, 1, fake.GetProfilesCallCount())
@@ -126,7 +127,7 @@ func TestClient_ListSandboxes_NonCri(t *testing.T) {
 
 	fake.GetProfilesReturns([]api.Profile{{Name: "foo"}, {Name: "bar"}}, nil)
 
-	sl, err := client.ListSandboxes()
+	sl, err := client.ListSandboxes(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, sl, 0)
 	assert.Equal(t, 1, fake.GetProfilesCallCount())
@@ -0,0 +1,53 @@
+package lxo
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/lxc/lxd/shared/api"
+)
+
+// Typed errors of LXD requests and operations, match them with errors.Is. The error of LXD is still wrapped, so
+// api.StatusErrorCheck keeps working.
+var (
+	ErrNotFound       = errors.New("not found")
+	ErrConflict       = errors.New("conflict")
+	ErrAlreadyStopped = errors.New("already stopped")
+	ErrAlreadyRunning = errors.New("already running")
+)
+
+// Error is an error of LXD classified as one of the typed errors
+type Error struct {
+	Kind error
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// mapError classifies the error of a LXD request by the status code of the response. Errors which can't be classified
+// are returned unchanged.
+func mapError(err error) error {
+	status, ok := api.StatusErrorMatch(err)
+	if !ok {
+		return err
+	}
+
+	switch status {
+	case http.StatusNotFound:
+		return &Error{Kind: ErrNotFound, Err: err}
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return &Error{Kind: ErrConflict, Err: err}
+	}
+
+	return err
+}
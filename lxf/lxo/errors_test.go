@@ -0,0 +1,43 @@
+package lxo
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mapError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{"not found", api.StatusErrorf(http.StatusNotFound, "Instance not found"), ErrNotFound},
+		{"already exists", api.StatusErrorf(http.StatusConflict, "Instance already exists"), ErrConflict},
+		{"etag", api.StatusErrorf(http.StatusPreconditionFailed, "ETag doesn't match"), ErrConflict},
+		{"internal", api.StatusErrorf(http.StatusInternalServerError, "failed"), nil},
+		{"untyped", errors.New("failed"), nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := mapError(tt.err)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.err.Error(), err.Error())
+
+			if tt.kind != nil {
+				assert.ErrorIs(t, err, tt.kind)
+			} else {
+				assert.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
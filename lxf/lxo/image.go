@@ -1,6 +1,7 @@
 package lxo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -9,23 +10,23 @@ import (
 )
 
 // CopyImage will copy an image from the specified server waits till operation is done
-func (l *LXO) CopyImage(source lxd.ImageServer, image api.Image, args *lxd.ImageCopyArgs) error {
+func (l *LXO) CopyImage(ctx context.Context, source lxd.ImageServer, image api.Image, args *lxd.ImageCopyArgs) error {
 	op, err := l.server.CopyImage(source, image, args)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
-	return op.Wait()
+	return waitRemote(ctx, l.timeouts.Image, op)
 }
 
 // DeleteImage will delete an image and waits till operation is done
-func (l *LXO) DeleteImage(hash string) error {
+func (l *LXO) DeleteImage(ctx context.Context, hash string) error {
 	op, err := l.server.DeleteImage(hash)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
-	return op.Wait()
+	return wait(ctx, l.timeouts.Delete, op)
 }
 
 var (
@@ -33,13 +34,13 @@ var (
 )
 
 // CreateImage will create an image and waits till operation is done. Returns resulting fingerprint
-func (l *LXO) CreateImage(image api.ImagesPost, args *lxd.ImageCreateArgs) (string, error) {
+func (l *LXO) CreateImage(ctx context.Context, image api.ImagesPost, args *lxd.ImageCreateArgs) (string, error) {
 	op, err := l.server.CreateImage(image, args)
 	if err != nil {
-		return "", err
+		return "", mapError(err)
 	}
 
-	err = op.Wait()
+	err = wait(ctx, l.timeouts.Image, op)
 	if err != nil {
 		return "", err
 	}
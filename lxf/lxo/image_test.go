@@ -1,6 +1,7 @@
 package lxo
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -19,7 +20,7 @@ func TestLXO_CopyImage_Simple(t *testing.T) {
 	fake.CopyImageReturns(fakeOp, nil)
 	fakeOp.WaitReturns(nil)
 
-	err := lxo.CopyImage(sourceFake, api.Image{}, nil)
+	err := lxo.CopyImage(context.Background(), sourceFake, api.Image{}, nil)
 	assert.NoError(t, err)
 
 	assert.Equal(t, 1, fake.CopyImageCallCount())
@@ -35,7 +36,7 @@ func TestLXO_CopyImage_Error(t *testing.T) {
 
 	fake.CopyImageReturns(fakeOp, errors.New("something failed"))
 
-	err := lxo.CopyImage(sourceFake, api.Image{}, nil)
+	err := lxo.CopyImage(context.Background(), sourceFake, api.Image{}, nil)
 	assert.Error(t, err)
 
 	assert.Equal(t, 1, fake.CopyImageCallCount())
@@ -51,7 +52,7 @@ func TestLXO_DeleteImage_Simple(t *testing.T) {
 	fake.DeleteImageReturns(fakeOp, nil)
 	fakeOp.WaitReturns(nil)
 
-	err := lxo.DeleteImage("foo")
+	err := lxo.DeleteImage(context.Background(), "foo")
 	assert.NoError(t, err)
 
 	assert.Equal(t, 1, fake.DeleteImageCallCount())
@@ -66,7 +67,7 @@ func TestLXO_DeleteImage_Error(t *testing.T) {
 
 	fake.DeleteImageReturns(fakeOp, errors.New("something failed"))
 
-	err := lxo.DeleteImage("foo")
+	err := lxo.DeleteImage(context.Background(), "foo")
 	assert.Error(t, err)
 
 	assert.Equal(t, 1, fake.DeleteImageCallCount())
@@ -83,7 +84,7 @@ func TestLXO_CreateImage_Simple(t *testing.T) {
 	fakeOp.WaitReturns(nil)
 	fakeOp.GetReturns(api.Operation{Metadata: map[string]any{"fingerprint": "abcdefg"}})
 
-	fingerprint, err := lxo.CreateImage(api.ImagesPost{}, nil)
+	fingerprint, err := lxo.CreateImage(context.Background(), api.ImagesPost{}, nil)
 	assert.NoError(t, err)
 
 	assert.Equal(t, "abcdefg", fingerprint)
@@ -100,7 +101,7 @@ func TestLXO_CreateImage_Error(t *testing.T) {
 
 	fake.CreateImageReturns(fakeOp, errors.New("something failed"))
 
-	fingerprint, err := lxo.CreateImage(api.ImagesPost{}, nil)
+	fingerprint, err := lxo.CreateImage(context.Background(), api.ImagesPost{}, nil)
 	assert.Error(t, err)
 
 	assert.Equal(t, "", fingerprint)
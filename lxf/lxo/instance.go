@@ -0,0 +1,155 @@
+package lxo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared"
+	"github.com/lxc/lxd/shared/api"
+)
+
+// StopInstance will try to stop the instance and waits till operation is done. An instance which is already
+// stopped is not an error.
+func (l *LXO) StopInstance(ctx context.Context, id string, timeout, retries int) error {
+	var (
+		err  error
+		etag string
+	)
+
+	for i := 0; i <= retries; i++ {
+		lxdReq := api.InstanceStatePut{
+			Action:  string(shared.Stop),
+			Timeout: timeout,
+			Force:   i == retries,
+		}
+
+		var op lxd.Operation
+
+		op, err = l.server.UpdateInstanceState(id, lxdReq, etag)
+		if err != nil {
+			return mapError(err)
+		}
+
+		err = wait(ctx, l.stopTimeout(timeout), op)
+		if err == nil {
+			return nil
+		}
+
+		err = l.stateError(id, shared.Stop, err)
+		if errors.Is(err, ErrAlreadyStopped) {
+			return nil
+		}
+
+		// no retry if the caller gave up or the operation took too long
+		if ctx.Err() != nil || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// stopTimeout is the time LXD waits for the clean shutdown plus the configured timeout
+func (l *LXO) stopTimeout(timeout int) time.Duration {
+	if l.timeouts.Stop <= 0 {
+		return 0
+	}
+
+	if timeout > 0 {
+		return time.Duration(timeout)*time.Second + l.timeouts.Stop
+	}
+
+	return l.timeouts.Stop
+}
+
+// StartInstance will start the instance and waits till operation is done. Returns ErrAlreadyRunning if it's
+// running already.
+func (l *LXO) StartInstance(ctx context.Context, id string) error {
+	ETag := ""
+	lxdReq := api.InstanceStatePut{
+		Action:  string(shared.Start),
+		Timeout: -1,
+	}
+
+	op, err := l.server.UpdateInstanceState(id, lxdReq, ETag)
+	if err != nil {
+		return mapError(err)
+	}
+
+	err = wait(ctx, l.timeouts.Start, op)
+	if err != nil {
+		return l.stateError(id, shared.Start, err)
+	}
+
+	return nil
+}
+
+// stateError classifies the error of a failed start or stop operation. LXD reports these failures only with a
+// message, so the status code of the instance state is consulted instead. Only a start failing on a running instance
+// is ErrAlreadyRunning and only a stop failing on a stopped instance is ErrAlreadyStopped, otherwise the error is
+// returned as is.
+func (l *LXO) stateError(id string, action shared.InstanceAction, err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	state, _, stateErr := l.server.GetInstanceState(id)
+	if stateErr != nil || state == nil {
+		return err
+	}
+
+	switch {
+	case action == shared.Stop && state.StatusCode == api.Stopped:
+		return &Error{Kind: ErrAlreadyStopped, Err: err}
+	case action == shared.Start && state.StatusCode == api.Running:
+		return &Error{Kind: ErrAlreadyRunning, Err: err}
+	}
+
+	return err
+}
+
+// CreateInstance will create the instance and waits till operation is done
+func (l *LXO) CreateInstance(ctx context.Context, instance api.InstancesPost) error {
+	op, err := l.server.CreateInstance(instance)
+	if err != nil {
+		return mapError(err)
+	}
+
+	return wait(ctx, l.timeouts.Create, op)
+}
+
+// UpdateInstance will update the instance and waits till operation is done
+func (l *LXO) UpdateInstance(ctx context.Context, id string, instance api.InstancePut, etag string) error {
+	op, err := l.server.UpdateInstance(id, instance, etag)
+	if err != nil {
+		return mapError(err)
+	}
+
+	return wait(ctx, l.timeouts.Update, op)
+}
+
+// DeleteInstance will delete the instance and waits till operation is done
+func (l *LXO) DeleteInstance(ctx context.Context, id string) error {
+	op, err := l.server.DeleteInstance(id)
+	if err != nil {
+		return mapError(err)
+	}
+
+	return wait(ctx, l.timeouts.Delete, op)
+}
+
+// MoveInstance will move the stopped container into another project and waits till operation is done
+func (l *LXO) MoveInstance(ctx context.Context, id string, project string) error {
+	op, err := l.server.MigrateInstance(id, api.InstancePost{
+		Name:      id,
+		Migration: true,
+		Project:   project,
+	})
+	if err != nil {
+		return mapError(err)
+	}
+
+	return wait(ctx, l.timeouts.Move, op)
+}
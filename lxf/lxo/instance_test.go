@@ -0,0 +1,308 @@
+package lxo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLXO_StopInstance_Simple(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturns(nil)
+
+	err := lxo.StopInstance(context.Background(), "foo", 10, 0)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 1, fakeOp.WaitCallCount())
+}
+
+func TestLXO_StopInstance_Error(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, errors.New("something failed"))
+
+	err := lxo.StopInstance(context.Background(), "foo", 10, 0)
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 0, fakeOp.WaitCallCount())
+}
+
+func TestLXO_StopInstance_ForceSuccess(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturnsOnCall(0, errors.New("some error"))
+	fakeOp.WaitReturnsOnCall(1, nil)
+
+	err := lxo.StopInstance(context.Background(), "foo", 5, 1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 2, fakeOp.WaitCallCount())
+}
+
+func TestLXO_StopInstance_ForceFailed(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturnsOnCall(0, errors.New("some error"))
+	fakeOp.WaitReturnsOnCall(1, errors.New("still error"))
+
+	err := lxo.StopInstance(context.Background(), "foo", 5, 1)
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 2, fakeOp.WaitCallCount())
+}
+
+func TestLXO_StopInstance_AlreadyStopped(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturnsOnCall(0, errors.New("The instance is already stopped"))
+	fake.GetInstanceStateReturns(&api.InstanceState{StatusCode: api.Stopped}, "", nil)
+
+	err := lxo.StopInstance(context.Background(), "foo", 5, 1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 1, fakeOp.WaitCallCount())
+}
+
+func TestLXO_StopInstance_Timeout(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	lxo.timeouts.Stop = time.Millisecond
+	fakeOp := &lxdfakes.FakeOperation{}
+	release := make(chan struct{})
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitStub = func() error {
+		<-release
+
+		return nil
+	}
+	fakeOp.CancelStub = func() error {
+		close(release)
+
+		return nil
+	}
+
+	err := lxo.StopInstance(context.Background(), "foo", 0, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the forced stop isn't tried after the timeout
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 1, fakeOp.CancelCallCount())
+}
+
+func TestLXO_StartInstance_Simple(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturns(nil)
+
+	err := lxo.StartInstance(context.Background(), "foo")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 1, fakeOp.WaitCallCount())
+}
+
+func TestLXO_StartInstance_AlreadyRunning(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturns(errors.New("The instance is already running"))
+	fake.GetInstanceStateReturns(&api.InstanceState{StatusCode: api.Running}, "", nil)
+
+	err := lxo.StartInstance(context.Background(), "foo")
+	assert.ErrorIs(t, err, ErrAlreadyRunning)
+	assert.EqualError(t, err, "The instance is already running")
+}
+
+func TestLXO_StartInstance_FailedWhileStopped(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturns(errors.New("Failed to run: forkstart"))
+	fake.GetInstanceStateReturns(&api.InstanceState{StatusCode: api.Stopped}, "", nil)
+
+	// a start failing on a stopped instance is not ErrAlreadyStopped
+	err := lxo.StartInstance(context.Background(), "foo")
+	assert.NotErrorIs(t, err, ErrAlreadyStopped)
+	assert.NotErrorIs(t, err, ErrAlreadyRunning)
+	assert.EqualError(t, err, "Failed to run: forkstart")
+}
+
+func TestLXO_StopInstance_FailedWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, nil)
+	fakeOp.WaitReturns(errors.New("Failed shutting down instance"))
+	fake.GetInstanceStateReturns(&api.InstanceState{StatusCode: api.Running}, "", nil)
+
+	// a stop failing on a running instance is not ErrAlreadyRunning
+	err := lxo.StopInstance(context.Background(), "foo", 0, 0)
+	assert.NotErrorIs(t, err, ErrAlreadyRunning)
+	assert.EqualError(t, err, "Failed shutting down instance")
+}
+
+func TestLXO_StartInstance_NotFound(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+
+	fake.UpdateInstanceStateReturns(nil, api.StatusErrorf(http.StatusNotFound, "Instance not found"))
+
+	err := lxo.StartInstance(context.Background(), "foo")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.True(t, api.StatusErrorCheck(err, http.StatusNotFound))
+	assert.Equal(t, 0, fake.GetInstanceStateCallCount())
+}
+
+func TestLXO_StartInstance_Error(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceStateReturns(fakeOp, errors.New("something missing"))
+
+	err := lxo.StartInstance(context.Background(), "foo")
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+	assert.Equal(t, 0, fakeOp.WaitCallCount())
+}
+
+func TestLXO_CreateInstance_Simple(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.CreateInstanceReturns(fakeOp, nil)
+	fakeOp.WaitReturns(nil)
+
+	err := lxo.CreateInstance(context.Background(), api.InstancesPost{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.CreateInstanceCallCount())
+	assert.Equal(t, 1, fakeOp.WaitCallCount())
+}
+
+func TestLXO_CreateInstance_Error(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.CreateInstanceReturns(fakeOp, errors.New("something failed"))
+
+	err := lxo.CreateInstance(context.Background(), api.InstancesPost{})
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.CreateInstanceCallCount())
+	assert.Equal(t, 0, fakeOp.WaitCallCount())
+}
+
+func TestLXO_UpdateInstance_Simple(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceReturns(fakeOp, nil)
+	fakeOp.WaitReturns(nil)
+
+	err := lxo.UpdateInstance(context.Background(), "foo", api.InstancePut{}, "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceCallCount())
+	assert.Equal(t, 1, fakeOp.WaitCallCount())
+}
+
+func TestLXO_UpdateInstance_Error(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.UpdateInstanceReturns(fakeOp, errors.New("something failed"))
+
+	err := lxo.UpdateInstance(context.Background(), "foo", api.InstancePut{}, "")
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.UpdateInstanceCallCount())
+	assert.Equal(t, 0, fakeOp.WaitCallCount())
+}
+
+func TestLXO_DeleteInstance_Simple(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.DeleteInstanceReturns(fakeOp, nil)
+	fakeOp.WaitReturns(nil)
+
+	err := lxo.DeleteInstance(context.Background(), "foo")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.DeleteInstanceCallCount())
+	assert.Equal(t, 1, fakeOp.WaitCallCount())
+}
+
+func TestLXO_DeleteInstance_Error(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+
+	fake.DeleteInstanceReturns(fakeOp, errors.New("something failed"))
+
+	err := lxo.DeleteInstance(context.Background(), "foo")
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, fake.DeleteInstanceCallCount())
+	assert.Equal(t, 0, fakeOp.WaitCallCount())
+}
@@ -1,18 +1,73 @@
 package lxo
 
 import (
+	"context"
+	"time"
+
 	lxd "github.com/lxc/lxd/client"
 )
 
 // LXO abstracts some of the lxd calls with additional functionality like retrying, idempotency
-// and some level of error recovery. Usage stays the same as lxd.ContainerServer
+// and some level of error recovery. Usage stays the same as lxd.InstanceServer
 type LXO struct {
-	server lxd.ContainerServer
+	server   lxd.InstanceServer
+	timeouts Timeouts
+}
+
+// Timeouts limit how long is waited for the operations of LXD. When exceeded, the operation is cancelled and
+// context.DeadlineExceeded returned. 0 waits as long as the context allows.
+type Timeouts struct {
+	Create time.Duration
+	Start  time.Duration
+	// Stop is added to the timeout of the stop request itself, as LXD waits that long for the clean shutdown
+	Stop   time.Duration
+	Update time.Duration
+	Delete time.Duration
+	// Move of containers to another project
+	Move  time.Duration
+	Image time.Duration
 }
 
 // New creates LXO
-func NewClient(server lxd.ContainerServer) *LXO {
+func NewClient(server lxd.InstanceServer, timeouts Timeouts) *LXO {
 	return &LXO{
-		server: server,
+		server:   server,
+		timeouts: timeouts,
+	}
+}
+
+// wait till the operation is done. If the context is done or the timeout is exceeded first, the operation is
+// cancelled and the error of the context is returned. The LXD client used doesn't offer waiting with a context.
+func wait(ctx context.Context, timeout time.Duration, op lxd.Operation) error {
+	return waitFn(ctx, timeout, op.Wait, op.Cancel)
+}
+
+// waitRemote is wait for operations possibly using multiple servers
+func waitRemote(ctx context.Context, timeout time.Duration, op lxd.RemoteOperation) error {
+	return waitFn(ctx, timeout, op.Wait, op.CancelTarget)
+}
+
+func waitFn(ctx context.Context, timeout time.Duration, waitFn func() error, cancelFn func() error) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- waitFn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		// not every operation can be cancelled, the context error is what matters to the caller
+		_ = cancelFn()
+
+		return ctx.Err()
 	}
 }
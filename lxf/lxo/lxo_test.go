@@ -1,14 +1,15 @@
 package lxo
 
 import (
+	"context"
 	"testing"
 
 	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
 	"github.com/stretchr/testify/assert"
 )
 
-func newFakeClient() (*LXO, *lxdfakes.FakeContainerServer) {
-	fake := &lxdfakes.FakeContainerServer{}
+func newFakeClient() (*LXO, *lxdfakes.FakeInstanceServer) {
+	fake := &lxdfakes.FakeInstanceServer{}
 
 	return &LXO{
 		server: fake,
@@ -18,10 +19,37 @@ func newFakeClient() (*LXO, *lxdfakes.FakeContainerServer) {
 func TestNewClient(t *testing.T) {
 	t.Parallel()
 
-	fake := &lxdfakes.FakeContainerServer{}
+	fake := &lxdfakes.FakeInstanceServer{}
 
-	lxo := NewClient(fake)
+	lxo := NewClient(fake, Timeouts{})
 	assert.NotNil(t, lxo)
 
 	assert.Exactly(t, fake, lxo.server)
 }
+
+func TestLXO_wait_Cancel(t *testing.T) {
+	t.Parallel()
+
+	lxo, fake := newFakeClient()
+	fakeOp := &lxdfakes.FakeOperation{}
+	release := make(chan struct{})
+
+	fake.DeleteInstanceReturns(fakeOp, nil)
+	fakeOp.WaitStub = func() error {
+		<-release
+
+		return nil
+	}
+	fakeOp.CancelStub = func() error {
+		close(release)
+
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := lxo.DeleteInstance(ctx, "foo")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, fakeOp.CancelCallCount())
+}
@@ -0,0 +1,303 @@
+package lxf
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/automaticserver/lxe/lxf/lxo"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+)
+
+const (
+	namespaceWildcard = "*"
+	// projectMissTTL is how long an id not found in any namespace project is looked up in the project of the
+	// connection without scanning the namespace projects again
+	projectMissTTL = 5 * time.Second
+)
+
+// namespaceProjectFeatures isolate profiles and volumes per namespace. Images are shared with the default project, so
+// they are only pulled once, and networks as well, so the lxd bridge of the network plugin is still reachable.
+var namespaceProjectFeatures = map[string]string{
+	"features.images":          "false",
+	"features.profiles":        "true",
+	"features.storage.volumes": "true",
+	"features.networks":        "false",
+}
+
+// NamespaceProjects maps each kubernetes namespace to its own LXD project named by Prefix and the namespace
+type NamespaceProjects struct {
+	Enabled bool
+	// Prefix of the project names, it distinguishes the projects of LXE from others
+	Prefix string
+	// Policies define the limits and restrictions of the projects, the first policy matching the namespace is used
+	Policies []NamespaceProjectPolicy
+}
+
+// NamespaceProjectPolicy sets the project config of the matched namespaces
+type NamespaceProjectPolicy struct {
+	// Namespaces the policy applies to, "*" matches all
+	Namespaces []string
+	// Config of the project, only `limits.*` and `restricted*` keys are allowed
+	Config map[string]string
+}
+
+// Validate checks the prefix is set and the policies only contain limits and restrictions
+func (n NamespaceProjects) Validate() error {
+	if !n.Enabled {
+		return nil
+	}
+
+	if n.Prefix == "" {
+		return fmt.Errorf("%w: namespace projects need a prefix", ErrUsage)
+	}
+
+	for i, p := range n.Policies {
+		if len(p.Namespaces) == 0 {
+			return fmt.Errorf("%w: namespace project policy %d: no namespaces", ErrUsage, i)
+		}
+
+		for k := range p.Config {
+			if !strings.HasPrefix(k, "limits.") && !strings.HasPrefix(k, "restricted") {
+				return fmt.Errorf("%w: namespace project policy %d: key not allowed: %s", ErrUsage, i, k)
+			}
+		}
+	}
+
+	return nil
+}
+
+// project returns the name of the project of the namespace
+func (n NamespaceProjects) project(namespace string) string {
+	return n.Prefix + namespace
+}
+
+// isNamespaceProject reports whether the project is one of the namespace projects
+func (n NamespaceProjects) isNamespaceProject(project string) bool {
+	return n.Enabled && strings.HasPrefix(project, n.Prefix)
+}
+
+// config returns the project config for the namespace, made of the features and the first matching policy
+func (n NamespaceProjects) config(namespace string) map[string]string {
+	config := map[string]string{}
+	for k, v := range namespaceProjectFeatures {
+		config[k] = v
+	}
+
+	for _, p := range n.Policies {
+		if !p.matches(namespace) {
+			continue
+		}
+
+		for k, v := range p.Config {
+			config[k] = v
+		}
+
+		break
+	}
+
+	return config
+}
+
+func (p NamespaceProjectPolicy) matches(namespace string) bool {
+	for _, ns := range p.Namespaces {
+		if ns == namespaceWildcard || ns == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// projectIndex remembers in which project a sandbox or container lives, and for a short time the ids not found in any
+// namespace project
+type projectIndex struct {
+	mu     sync.RWMutex
+	ids    map[string]string
+	misses map[string]time.Time
+}
+
+func newProjectIndex() *projectIndex {
+	return &projectIndex{ids: map[string]string{}, misses: map[string]time.Time{}}
+}
+
+func (i *projectIndex) get(id string) (string, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	project, has := i.ids[id]
+
+	return project, has
+}
+
+func (i *projectIndex) set(id, project string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.ids[id] = project
+	delete(i.misses, id)
+}
+
+func (i *projectIndex) delete(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.ids, id)
+	delete(i.misses, id)
+}
+
+// missed checks whether the id wasn't found in any namespace project within projectMissTTL
+func (i *projectIndex) missed(id string) bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	at, has := i.misses[id]
+
+	return has && time.Since(at) < projectMissTTL
+}
+
+// miss remembers that the id wasn't found in any namespace project, expired misses are dropped
+func (i *projectIndex) miss(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := time.Now()
+
+	for k, at := range i.misses {
+		if now.Sub(at) >= projectMissTTL {
+			delete(i.misses, k)
+		}
+	}
+
+	i.misses[id] = now
+}
+
+// projectServer returns the server scoped to the project, the empty project is the one of the connection
+func (l *client) projectServer(project string) lxd.InstanceServer { // nolint: ireturn
+	if project == "" {
+		return l.currentServer()
+	}
+
+	return l.currentServer().UseProject(project)
+}
+
+// projectOpwait returns the operation helper scoped to the project, the empty project is the one of the connection
+func (l *client) projectOpwait(project string) *lxo.LXO {
+	if project == "" {
+		return l.currentOpwait()
+	}
+
+	return lxo.NewClient(l.currentServer().UseProject(project), l.conn.OperationTimeouts)
+}
+
+// projects returns the projects objects are looked up in. The empty project of the connection is always included, so
+// objects created before namespace projects were enabled are still found.
+func (l *client) projects() ([]string, error) {
+	projects := []string{""}
+
+	if !l.conn.NamespaceProjects.Enabled {
+		return projects, nil
+	}
+
+	names, err := l.currentServer().GetProjectNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if l.conn.NamespaceProjects.isNamespaceProject(name) {
+			projects = append(projects, name)
+		}
+	}
+
+	return projects, nil
+}
+
+// namespaceProject ensures the project of the namespace exists and returns its name. Without namespace projects it's
+// the project of the connection.
+func (l *client) namespaceProject(namespace string) (string, error) {
+	if !l.conn.NamespaceProjects.Enabled {
+		return "", nil
+	}
+
+	project := l.conn.NamespaceProjects.project(namespace)
+
+	err := ensureProject(l.currentServer(), project, l.conn.NamespaceProjects.config(namespace), l.conn.Profiles)
+	if err != nil {
+		return "", err
+	}
+
+	return project, nil
+}
+
+// findProject returns the project the sandbox or container with the id lives in. If the id isn't indexed yet, all
+// namespace projects are scanned. Ids not found in any of them are looked up in the project of the connection, and
+// for projectMissTTL without scanning again, as kubelet keeps asking for objects which are gone.
+func (l *client) findProject(id string) (string, error) {
+	if !l.conn.NamespaceProjects.Enabled {
+		return "", nil
+	}
+
+	if project, has := l.projectIndex.get(id); has {
+		return project, nil
+	}
+
+	if l.projectIndex.missed(id) {
+		return "", nil
+	}
+
+	err := l.indexProjects()
+	if err != nil {
+		return "", err
+	}
+
+	project, has := l.projectIndex.get(id)
+	if !has {
+		l.projectIndex.miss(id)
+	}
+
+	return project, nil
+}
+
+// indexProjects adds all profiles and containers of the namespace projects to the index
+func (l *client) indexProjects() error {
+	projects, err := l.projects()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		if project == "" {
+			continue
+		}
+
+		server := l.projectServer(project)
+
+		profiles, err := server.GetProfileNames()
+		if err != nil {
+			return err
+		}
+
+		containers, err := server.GetInstanceNames(api.InstanceTypeAny)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range append(profiles, containers...) {
+			if id != defaultProfile {
+				l.projectIndex.set(id, project)
+			}
+		}
+	}
+
+	return nil
+}
+
+// indexObject remembers the project of the id if it's a namespace project
+func (l *client) indexObject(id, project string) {
+	if project != "" {
+		l.projectIndex.set(id, project)
+	}
+}
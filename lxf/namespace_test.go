@@ -0,0 +1,198 @@
+package lxf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceProjects_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		n       NamespaceProjects
+		wantErr bool
+	}{
+		{"disabled", NamespaceProjects{Prefix: ""}, false},
+		{"no prefix", NamespaceProjects{Enabled: true}, true},
+		{"limits", NamespaceProjects{Enabled: true, Prefix: "k8s-", Policies: []NamespaceProjectPolicy{
+			{Namespaces: []string{"*"}, Config: map[string]string{"limits.cpu": "4", "restricted": "true", "restricted.containers.nesting": "block"}},
+		}}, false},
+		{"no namespaces", NamespaceProjects{Enabled: true, Prefix: "k8s-", Policies: []NamespaceProjectPolicy{
+			{Config: map[string]string{"limits.cpu": "4"}},
+		}}, true},
+		{"features not allowed", NamespaceProjects{Enabled: true, Prefix: "k8s-", Policies: []NamespaceProjectPolicy{
+			{Namespaces: []string{"*"}, Config: map[string]string{"features.images": "true"}},
+		}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.n.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUsage)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNamespaceProjects_config(t *testing.T) {
+	t.Parallel()
+
+	n := NamespaceProjects{Enabled: true, Prefix: "k8s-", Policies: []NamespaceProjectPolicy{
+		{Namespaces: []string{"production"}, Config: map[string]string{"limits.memory": "8GiB"}},
+		{Namespaces: []string{"*"}, Config: map[string]string{"limits.memory": "1GiB", "limits.containers": "10"}},
+	}}
+
+	prod := n.config("production")
+	assert.Equal(t, "8GiB", prod["limits.memory"])
+	assert.NotContains(t, prod, "limits.containers")
+	assert.Equal(t, "false", prod["features.images"])
+
+	other := n.config("default")
+	assert.Equal(t, "1GiB", other["limits.memory"])
+	assert.Equal(t, "10", other["limits.containers"])
+
+	assert.Equal(t, "k8s-production", n.project("production"))
+	assert.True(t, n.isNamespaceProject("k8s-production"))
+	assert.False(t, n.isNamespaceProject("default"))
+}
+
+func testNamespaceClient() (*client, *lxdfakes.FakeInstanceServer, *lxdfakes.FakeInstanceServer) {
+	c, fake := testClient()
+	c.conn.NamespaceProjects = NamespaceProjects{Enabled: true, Prefix: "k8s-"}
+
+	projectFake := &lxdfakes.FakeInstanceServer{}
+	fake.UseProjectStub = func(name string) lxd.InstanceServer {
+		return projectFake
+	}
+
+	fake.GetProjectNamesReturns([]string{"default", "k8s-foo", "other"}, nil)
+
+	return c, fake, projectFake
+}
+
+func TestClient_findProject(t *testing.T) {
+	t.Parallel()
+
+	c, fake, projectFake := testNamespaceClient()
+	projectFake.GetProfileNamesReturns([]string{"default", "sandbox"}, nil)
+	projectFake.GetInstanceNamesReturns([]string{"container"}, nil)
+
+	project, err := c.findProject("sandbox")
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s-foo", project)
+
+	project, err = c.findProject("container")
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s-foo", project)
+
+	// found in the index without scanning again
+	assert.Equal(t, 1, fake.GetProjectNamesCallCount())
+
+	// unknown ids are looked up in the project of the connection
+	project, err = c.findProject("legacy")
+	assert.NoError(t, err)
+	assert.Equal(t, "", project)
+	assert.Equal(t, 2, fake.GetProjectNamesCallCount())
+
+	// missed ids are not scanned again for a while
+	project, err = c.findProject("legacy")
+	assert.NoError(t, err)
+	assert.Equal(t, "", project)
+	assert.Equal(t, 2, fake.GetProjectNamesCallCount())
+
+	_, has := c.projectIndex.get(defaultProfile)
+	assert.False(t, has)
+}
+
+func TestClient_findProject_MissExpired(t *testing.T) {
+	t.Parallel()
+
+	c, fake, projectFake := testNamespaceClient()
+
+	_, err := c.findProject("sandbox")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.GetProjectNamesCallCount())
+
+	// the sandbox was created out-of-band after the miss expired
+	c.projectIndex.misses["sandbox"] = time.Now().Add(-projectMissTTL)
+	projectFake.GetProfileNamesReturns([]string{"default", "sandbox"}, nil)
+
+	project, err := c.findProject("sandbox")
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s-foo", project)
+	assert.Equal(t, 2, fake.GetProjectNamesCallCount())
+	assert.False(t, c.projectIndex.missed("sandbox"))
+}
+
+func TestClient_GetSandbox_NamespaceProject(t *testing.T) {
+	t.Parallel()
+
+	c, fake, projectFake := testNamespaceClient()
+	c.projectIndex.set("sandbox", "k8s-foo")
+
+	p := getSchemaProfile(SchemaVersionProfile)
+	p.Name = "sandbox"
+	projectFake.GetProfileReturns(satisfyProfileCri(&p), "etag", nil)
+
+	s, err := c.GetSandbox(context.Background(), "sandbox")
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s-foo", s.project)
+	assert.Equal(t, 0, fake.GetProfileCallCount())
+	assert.Equal(t, "k8s-foo", fake.UseProjectArgsForCall(0))
+}
+
+func TestClient_ListContainers_NamespaceProjects(t *testing.T) {
+	t.Parallel()
+
+	c, fake, projectFake := testNamespaceClient()
+
+	legacy := getSchemaContainer(SchemaVersionContainer)
+	legacy.Name = "legacy"
+	legacy.Profiles = []string{"sandbox1"}
+	fake.GetInstancesReturns([]api.Instance{*satisfyContainerCri(&legacy)}, nil)
+
+	ct := getSchemaContainer(SchemaVersionContainer)
+	ct.Name = "container"
+	ct.Profiles = []string{"sandbox2"}
+	projectFake.GetInstancesReturns([]api.Instance{*satisfyContainerCri(&ct)}, nil)
+
+	cl, err := c.ListContainers(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, cl, 2)
+	assert.Equal(t, "", cl[0].project)
+	assert.Equal(t, "k8s-foo", cl[1].project)
+
+	project, has := c.projectIndex.get("container")
+	assert.True(t, has)
+	assert.Equal(t, "k8s-foo", project)
+}
+
+func TestGetContainerIDFromSelflink_Project(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "foo", GetContainerIDFromSelflink("/1.0/instances/foo?project=k8s-bar"))
+	assert.Equal(t, "foo", GetContainerIDFromSelflink("/1.0/containers/foo"))
+}
+
+func TestNewClient_NamespaceProjectsWithDedicatedProject(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(ConnectionConfig{
+		Project:           "lxe",
+		NamespaceProjects: NamespaceProjects{Enabled: true, Prefix: "k8s-"},
+	}, "")
+	assert.ErrorIs(t, err, ErrUsage)
+}
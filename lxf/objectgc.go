@@ -0,0 +1,257 @@
+package lxf
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dionysius/errand"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
+)
+
+// ObjectReconcileOptions control how sandboxes and containers are reconciled
+type ObjectReconcileOptions struct {
+	// Cleanup removes the found orphaned and outdated objects, otherwise they are only reported. Empty sandboxes are
+	// always only reported, as their network has to be torn down when they are removed.
+	Cleanup bool
+	// MinAge an object must have been created before it is considered. This prevents removing a sandbox whose
+	// containers are just being created.
+	MinAge time.Duration
+}
+
+// ObjectReconcileReport lists the findings of an object reconciliation
+type ObjectReconcileReport struct {
+	// EmptySandboxes are stopped sandboxes without containers
+	EmptySandboxes []string `json:"emptySandboxes" yaml:"emptySandboxes"`
+	// OrphanedContainers are cri containers whose sandbox doesn't exist anymore
+	OrphanedContainers []string `json:"orphanedContainers" yaml:"orphanedContainers"`
+	// OutdatedSandboxes are cri profiles whose schema can't be migrated, so they aren't visible as sandboxes
+	OutdatedSandboxes []string `json:"outdatedSandboxes" yaml:"outdatedSandboxes"`
+	// OutdatedContainers are cri containers whose schema can't be migrated, so they aren't visible as containers
+	OutdatedContainers []string `json:"outdatedContainers" yaml:"outdatedContainers"`
+	// Removed is true if the found orphaned and outdated objects have been removed
+	Removed bool `json:"removed" yaml:"removed"`
+}
+
+// ReconcileObjects finds stopped sandboxes without containers, containers without sandbox and cri objects in an
+// outdated schema. Objects in a newer schema are kept, as are the containers of their sandboxes. Only objects created
+// before the minimum age are considered. A ready sandbox without containers is
+// kept, as kubelet creates the containers after the sandbox.
+func (l *client) ReconcileObjects(ctx context.Context, opts ObjectReconcileOptions) (*ObjectReconcileReport, error) { // nolint: cyclop
+	projects, err := l.projects()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ObjectReconcileReport{
+		EmptySandboxes:     []string{},
+		OrphanedContainers: []string{},
+		OutdatedSandboxes:  []string{},
+		OutdatedContainers: []string{},
+	}
+
+	// the project of every found object, to remove it there
+	profileProjects := map[string]string{}
+	containerProjects := map[string]string{}
+
+	for _, project := range projects {
+		profiles, err := l.projectServer(project).GetProfiles()
+		if err != nil {
+			return nil, err
+		}
+
+		containers, err := l.projectServer(project).GetInstances(api.InstanceTypeAny)
+		if err != nil {
+			return nil, err
+		}
+
+		sandboxes := map[string]bool{}
+
+		for _, p := range profiles {
+			if !isMarkedCRI(p.Config) {
+				continue
+			}
+
+			outdated := isSchemaOutdated(p.Config[cfgSchema], SchemaVersionProfile, log.WithField("podid", p.Name))
+			if !outdated {
+				sandboxes[p.Name] = true
+			}
+
+			if !isCreatedBefore(profileCreatedAt(p), opts.MinAge) {
+				continue
+			}
+
+			switch {
+			case outdated:
+				report.OutdatedSandboxes = append(report.OutdatedSandboxes, p.Name)
+				profileProjects[p.Name] = project
+			case len(p.UsedBy) == 0 && getSandboxState(p.Config[cfgState]) == SandboxNotReady:
+				report.EmptySandboxes = append(report.EmptySandboxes, p.Name)
+			}
+		}
+
+		for _, c := range containers {
+			if !isMarkedCRI(c.Config) || !isCreatedBefore(c.CreatedAt, opts.MinAge) {
+				continue
+			}
+
+			switch {
+			case isSchemaOutdated(c.Config[cfgSchema], SchemaVersionContainer, log.WithField("containerid", c.Name)):
+				report.OutdatedContainers = append(report.OutdatedContainers, c.Name)
+				containerProjects[c.Name] = project
+			case len(c.Profiles) == 0 || !sandboxes[c.Profiles[len(c.Profiles)-1]]:
+				report.OrphanedContainers = append(report.OrphanedContainers, c.Name)
+				containerProjects[c.Name] = project
+			}
+		}
+	}
+
+	sort.Strings(report.EmptySandboxes)
+	sort.Strings(report.OrphanedContainers)
+	sort.Strings(report.OutdatedSandboxes)
+	sort.Strings(report.OutdatedContainers)
+
+	if !opts.Cleanup {
+		return report, nil
+	}
+
+	var errs error
+
+	// containers first, as the profiles they use can't be deleted
+	for _, id := range append(append([]string{}, report.OrphanedContainers...), report.OutdatedContainers...) {
+		log.WithField("containerid", id).Info("removing orphaned container")
+
+		err = l.removeInstance(ctx, containerProjects[id], id)
+		if err != nil {
+			errs = errand.Append(errs, err)
+		}
+	}
+
+	for _, id := range report.OutdatedSandboxes {
+		log.WithField("podid", id).Info("removing outdated sandbox")
+
+		err = l.removeProfile(profileProjects[id], id)
+		if err != nil {
+			errs = errand.Append(errs, err)
+		}
+	}
+
+	report.Removed = errs == nil
+
+	return report, errs
+}
+
+// removeInstance stops and deletes the instance, a missing one is ignored
+func (l *client) removeInstance(ctx context.Context, project, id string) error {
+	err := l.projectOpwait(project).StopInstance(ctx, id, 0, 0)
+	if err != nil && !IsNotFoundError(err) {
+		return err
+	}
+
+	err = l.projectOpwait(project).DeleteInstance(ctx, id)
+	if err != nil && !IsNotFoundError(err) {
+		return err
+	}
+
+	l.projectIndex.delete(id)
+	l.cache.removeContainer(project, id)
+
+	return nil
+}
+
+// removeProfile deletes the profile, a missing one is ignored
+func (l *client) removeProfile(project, id string) error {
+	err := l.projectServer(project).DeleteProfile(id)
+	if err != nil && !IsNotFoundError(err) {
+		return err
+	}
+
+	l.projectIndex.delete(id)
+	l.cache.removeProfile(project, id)
+
+	return nil
+}
+
+// isSchemaOutdated reports whether the schema of an object is older than the current one, an object without schema
+// predates them. Objects of a newer schema are written by a newer LXE, e.g. before a downgrade, and objects of an
+// unparsable schema by something else, both are kept and only warned about.
+func isSchemaOutdated(schema, current string, log *logrus.Entry) bool {
+	if schema == "" {
+		return true
+	}
+
+	cmp, err := compareSchema(schema, current)
+	if err != nil {
+		log.WithError(err).WithField("schema", schema).Warn("keeping cri object of unknown schema")
+
+		return false
+	}
+
+	if cmp > 0 {
+		log.WithField("schema", schema).Warn("keeping cri object of newer schema")
+	}
+
+	return cmp < 0
+}
+
+// compareSchema compares the schema versions a and b like 0.5 part by part, it returns -1 if a is older, 1 if it's
+// newer and 0 if it's the same
+func compareSchema(a, b string) (int, error) {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var (
+			x, y int
+			err  error
+		)
+
+		if i < len(partsA) {
+			x, err = strconv.Atoi(partsA[i])
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid schema version: %s", ErrParse, a)
+			}
+		}
+
+		if i < len(partsB) {
+			y, err = strconv.Atoi(partsB[i])
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid schema version: %s", ErrParse, b)
+			}
+		}
+
+		switch {
+		case x < y:
+			return -1, nil
+		case x > y:
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// isMarkedCRI reports whether the config marks a cri object, regardless of its schema
+func isMarkedCRI(config map[string]string) bool {
+	isCRI, _ := strconv.ParseBool(config[cfgIsCRI])
+
+	return isCRI
+}
+
+// profileCreatedAt returns when the sandbox of the profile was created, zero if it's unknown
+func profileCreatedAt(p api.Profile) time.Time {
+	createdAt, err := strconv.ParseInt(p.Config[cfgCreatedAt], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(0, createdAt)
+}
+
+// isCreatedBefore reports whether the object was created before minAge, an unknown creation time is old enough
+func isCreatedBefore(createdAt time.Time, minAge time.Duration) bool {
+	return time.Since(createdAt) >= minAge
+}
@@ -0,0 +1,142 @@
+package lxf
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func testReconcileObjects(fake *lxdfakes.FakeInstanceServer) {
+	old := time.Now().Add(-48 * time.Hour)
+
+	profile := func(name, schema string, createdAt time.Time, usedBy ...string) api.Profile {
+		p := api.Profile{Name: name, UsedBy: usedBy}
+		p.Config = map[string]string{cfgIsCRI: "true", cfgSchema: schema, cfgCreatedAt: strconv.FormatInt(createdAt.UnixNano(), 10), cfgState: SandboxNotReady.String()}
+
+		return p
+	}
+
+	// kubelet creates the containers after the sandbox
+	ready := profile("ready", SchemaVersionProfile, old)
+	ready.Config[cfgState] = SandboxReady.String()
+
+	fake.GetProfilesReturns([]api.Profile{
+		profile("used", SchemaVersionProfile, old, "/1.0/instances/running"),
+		profile("empty", SchemaVersionProfile, old),
+		ready,
+		profile("fresh", SchemaVersionProfile, time.Now()),
+		profile("outdated", "0.1", old),
+		{Name: "default"},
+	}, nil)
+
+	instance := func(name, schema string, createdAt time.Time, profiles ...string) api.Instance {
+		i := api.Instance{Name: name, CreatedAt: createdAt}
+		i.Config = map[string]string{cfgIsCRI: "true", cfgSchema: schema}
+		i.Profiles = profiles
+
+		return i
+	}
+
+	fake.GetInstancesReturns([]api.Instance{
+		instance("running", SchemaVersionContainer, old, "default", "used"),
+		instance("orphaned", SchemaVersionContainer, old, "default"),
+		instance("freshorphan", SchemaVersionContainer, time.Now(), "default"),
+		instance("outdated", "0.1", old, "default", "outdated"),
+		{Name: "foreign"},
+	}, nil)
+}
+
+func TestClient_ReconcileObjects_Report(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	testReconcileObjects(fake)
+
+	report, err := c.ReconcileObjects(context.Background(), ObjectReconcileOptions{MinAge: time.Hour})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"empty"}, report.EmptySandboxes)
+	assert.Equal(t, []string{"orphaned"}, report.OrphanedContainers)
+	assert.Equal(t, []string{"outdated"}, report.OutdatedSandboxes)
+	assert.Equal(t, []string{"outdated"}, report.OutdatedContainers)
+	assert.False(t, report.Removed)
+	assert.Equal(t, 0, fake.DeleteProfileCallCount())
+	assert.Equal(t, 0, fake.DeleteInstanceCallCount())
+}
+
+func TestClient_ReconcileObjects_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	testReconcileObjects(fake)
+	fake.UpdateInstanceStateReturns(&lxdfakes.FakeOperation{}, nil)
+	fake.DeleteInstanceReturns(&lxdfakes.FakeOperation{}, nil)
+
+	report, err := c.ReconcileObjects(context.Background(), ObjectReconcileOptions{Cleanup: true, MinAge: time.Hour})
+	assert.NoError(t, err)
+	assert.True(t, report.Removed)
+	assert.Equal(t, 2, fake.DeleteInstanceCallCount())
+	assert.Equal(t, "orphaned", fake.DeleteInstanceArgsForCall(0))
+	assert.Equal(t, "outdated", fake.DeleteInstanceArgsForCall(1))
+	// empty sandboxes are removed by the caller, as their network has to be torn down
+	assert.Equal(t, 1, fake.DeleteProfileCallCount())
+	assert.Equal(t, "outdated", fake.DeleteProfileArgsForCall(0))
+}
+
+// after a downgrade the objects written by the newer LXE must be kept
+func TestClient_ReconcileObjects_NewerSchema(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	old := time.Now().Add(-48 * time.Hour)
+
+	newer := api.Profile{Name: "newer"}
+	newer.Config = map[string]string{cfgIsCRI: "true", cfgSchema: "1.0", cfgCreatedAt: strconv.FormatInt(old.UnixNano(), 10), cfgState: SandboxReady.String()}
+	unknown := api.Profile{Name: "unknown"}
+	unknown.Config = map[string]string{cfgIsCRI: "true", cfgSchema: "next", cfgCreatedAt: strconv.FormatInt(old.UnixNano(), 10), cfgState: SandboxReady.String()}
+	fake.GetProfilesReturns([]api.Profile{newer, unknown}, nil)
+
+	container := api.Instance{Name: "container", CreatedAt: old}
+	container.Config = map[string]string{cfgIsCRI: "true", cfgSchema: SchemaVersionContainer}
+	container.Profiles = []string{"default", "newer"}
+	newerContainer := api.Instance{Name: "newercontainer", CreatedAt: old}
+	newerContainer.Config = map[string]string{cfgIsCRI: "true", cfgSchema: "0.10"}
+	newerContainer.Profiles = []string{"default", "unknown"}
+	fake.GetInstancesReturns([]api.Instance{container, newerContainer}, nil)
+
+	report, err := c.ReconcileObjects(context.Background(), ObjectReconcileOptions{Cleanup: true, MinAge: time.Hour})
+	assert.NoError(t, err)
+	assert.Empty(t, report.OrphanedContainers)
+	assert.Empty(t, report.OutdatedSandboxes)
+	assert.Empty(t, report.OutdatedContainers)
+	assert.Equal(t, 0, fake.DeleteProfileCallCount())
+	assert.Equal(t, 0, fake.DeleteInstanceCallCount())
+}
+
+func Test_compareSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"0.5", "0.5", 0},
+		{"0.1", "0.5", -1},
+		{"0.10", "0.5", 1},
+		{"1.0", "0.5", 1},
+		{"0.5.1", "0.5", 1},
+		{"0.5.0", "0.5", 0},
+	}
+	for _, tt := range tests {
+		got, err := compareSchema(tt.a, tt.b)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, tt.a)
+	}
+
+	_, err := compareSchema("next", "0.5")
+	assert.ErrorIs(t, err, ErrParse)
+}
@@ -0,0 +1,278 @@
+package lxf
+
+import (
+	"context"
+	"strings"
+
+	"github.com/automaticserver/lxe/lxf/lxo"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultProject = "default"
+	defaultProfile = "default"
+
+	defaultTimeoutProjectMove = 30 // seconds
+)
+
+// projectFeatures isolate images, profiles and volumes in the project, while networks are shared with the default
+// project so the lxd bridge of the network plugin is still reachable
+var projectFeatures = map[string]string{
+	"features.images":          "true",
+	"features.profiles":        "true",
+	"features.storage.volumes": "true",
+	"features.networks":        "false",
+}
+
+// isDedicatedProject reports whether a project other than the default one is used
+func isDedicatedProject(project string) bool {
+	return project != "" && project != defaultProject
+}
+
+// ensureProject creates the project with the given config if it doesn't exist, or updates the keys of the config
+// which differ if it does. The default profile of a created project is initialized from the default profile of the
+// default project, as the profile of a new project has neither a root disk nor a network device. The profiles the
+// containers use are copied from the default project if the project doesn't have them yet.
+func ensureProject(server lxd.InstanceServer, project string, config map[string]string, profiles []string) error {
+	existing, etag, err := server.GetProject(project)
+	if err == nil {
+		err = updateProjectConfig(server, existing, etag, config)
+		if err != nil {
+			return err
+		}
+
+		return ensureProjectProfiles(server, project, profiles)
+	}
+
+	if !IsNotFoundError(err) {
+		return err
+	}
+
+	err = server.CreateProject(api.ProjectsPost{
+		Name: project,
+		ProjectPut: api.ProjectPut{
+			Description: "Managed by LXE",
+			Config:      config,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	source, _, err := server.UseProject(defaultProject).GetProfile(defaultProfile)
+	if err != nil {
+		return err
+	}
+
+	target := server.UseProject(project)
+
+	_, etag, err = target.GetProfile(defaultProfile)
+	if err != nil {
+		return err
+	}
+
+	err = target.UpdateProfile(defaultProfile, source.Writable(), etag)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("project", project).Info("created lxd project")
+
+	return ensureProjectProfiles(server, project, profiles)
+}
+
+// ensureProjectProfiles copies the profiles missing in the project from the default project. Profiles which already
+// exist in the project are kept as they are. A profile missing in the default project too is skipped, creating the
+// containers using it fails as it would in the default project.
+func ensureProjectProfiles(server lxd.InstanceServer, project string, profiles []string) error {
+	source := server.UseProject(defaultProject)
+	target := server.UseProject(project)
+
+	for _, name := range profiles {
+		if name == defaultProfile {
+			continue
+		}
+
+		_, _, err := target.GetProfile(name)
+		if err == nil {
+			continue
+		}
+
+		if !IsNotFoundError(err) {
+			return err
+		}
+
+		profile, _, err := source.GetProfile(name)
+		if err != nil {
+			if IsNotFoundError(err) {
+				log.WithFields(logrus.Fields{"project": project, "profile": name}).Warn("profile not found in default project")
+
+				continue
+			}
+
+			return err
+		}
+
+		err = target.CreateProfile(api.ProfilesPost{Name: name, ProfilePut: profile.Writable()})
+		if err != nil {
+			return err
+		}
+
+		log.WithFields(logrus.Fields{"project": project, "profile": name}).Info("copied profile into lxd project")
+	}
+
+	return nil
+}
+
+// updateProjectConfig updates the project if any key of config differs, other keys of the project are kept
+func updateProjectConfig(server lxd.InstanceServer, project *api.Project, etag string, config map[string]string) error {
+	put := project.Writable()
+	if put.Config == nil {
+		put.Config = map[string]string{}
+	}
+
+	changed := false
+
+	for k, v := range config {
+		if put.Config[k] != v {
+			put.Config[k] = v
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	err := server.UpdateProject(project.Name, put, etag)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("project", project.Name).Info("updated lxd project config")
+
+	return nil
+}
+
+// ensureProject moves all objects created by lxe from the default project into the dedicated project. Profiles are
+// copied first, so the containers can be moved. Running containers are stopped for the move and started again.
+func (m *MigrationWorkspace) ensureProject(ctx context.Context) error { // nolint: gocognit, cyclop
+	project := m.lxf.conn.Project
+	if !isDedicatedProject(project) {
+		return nil
+	}
+
+	source := m.lxf.currentServer().UseProject(defaultProject)
+	sourceOp := lxo.NewClient(source, m.lxf.conn.OperationTimeouts)
+	log := log.WithField("project", project)
+
+	profiles, err := source.GetProfiles()
+	if err != nil {
+		return err
+	}
+
+	moveProfiles := []string{}
+
+	for _, p := range profiles {
+		if p.Config[cfgIsCRI] == "" && p.Config[cfgOldIsSandbox] == "" {
+			continue
+		}
+
+		_, _, err = m.lxf.currentServer().GetProfile(p.Name)
+		if err != nil && !IsNotFoundError(err) {
+			return err
+		}
+
+		if IsNotFoundError(err) {
+			err = m.lxf.currentServer().CreateProfile(api.ProfilesPost{Name: p.Name, ProfilePut: p.Writable()})
+			if err != nil {
+				return err
+			}
+		}
+
+		moveProfiles = append(moveProfiles, p.Name)
+	}
+
+	containers, err := source.GetInstances(api.InstanceTypeAny)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		if c.Config[cfgIsCRI] == "" && c.Config[cfgOldIsContainer] == "" {
+			continue
+		}
+
+		log := log.WithField("container", c.Name)
+		log.Warn("moving container into project")
+
+		running := c.StatusCode == api.Running
+		if running {
+			err = sourceOp.StopInstance(ctx, c.Name, defaultTimeoutProjectMove, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = sourceOp.MoveInstance(ctx, c.Name, project)
+		if err != nil {
+			return err
+		}
+
+		if running {
+			err = m.lxf.currentOpwait().StartInstance(ctx, c.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range moveProfiles {
+		log.WithField("profile", name).Warn("moved profile into project")
+
+		err = source.DeleteProfile(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.moveImagesToProject(ctx, source, sourceOp)
+}
+
+// moveImagesToProject copies the cri images with their lxe aliases into the project and deletes them in the source
+func (m *MigrationWorkspace) moveImagesToProject(ctx context.Context, source lxd.InstanceServer, sourceOp *lxo.LXO) error {
+	images, err := source.GetImages()
+	if err != nil {
+		return err
+	}
+
+	for _, img := range images {
+		if !m.lxf.IsCRI(img) {
+			continue
+		}
+
+		aliases := []api.ImageAlias{}
+
+		for _, a := range img.Aliases {
+			if strings.HasPrefix(a.Name, lxeAliasPrefix) {
+				aliases = append(aliases, a)
+			}
+		}
+
+		log.WithFields(logrus.Fields{"project": m.lxf.conn.Project, "fingerprint": img.Fingerprint}).Warn("moving image into project")
+
+		err = m.lxf.currentOpwait().CopyImage(ctx, source, img, &lxd.ImageCopyArgs{Aliases: aliases})
+		if err != nil {
+			return err
+		}
+
+		err = sourceOp.DeleteImage(ctx, img.Fingerprint)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package lxf
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	lxdfakes "github.com/automaticserver/lxe/fakes/lxd/client"
+	lxd "github.com/lxc/lxd/client"
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ensureProject_Exists(t *testing.T) {
+	t.Parallel()
+
+	_, fake := testClient()
+	fake.GetProjectReturns(&api.Project{Name: "lxe", ProjectPut: api.ProjectPut{Config: projectFeatures}}, "", nil)
+
+	err := ensureProject(fake, "lxe", projectFeatures, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fake.CreateProjectCallCount())
+	assert.Equal(t, 0, fake.UpdateProjectCallCount())
+}
+
+func Test_ensureProject_UpdateConfig(t *testing.T) {
+	t.Parallel()
+
+	_, fake := testClient()
+	fake.GetProjectReturns(&api.Project{Name: "lxe", ProjectPut: api.ProjectPut{Config: map[string]string{"limits.cpu": "2", "user.foo": "bar"}}}, "etag", nil)
+
+	err := ensureProject(fake, "lxe", map[string]string{"limits.cpu": "4"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.UpdateProjectCallCount())
+
+	name, put, etag := fake.UpdateProjectArgsForCall(0)
+	assert.Equal(t, "lxe", name)
+	assert.Equal(t, map[string]string{"limits.cpu": "4", "user.foo": "bar"}, put.Config)
+	assert.Equal(t, "etag", etag)
+}
+
+func Test_ensureProject_Create(t *testing.T) {
+	t.Parallel()
+
+	_, fake := testClient()
+	defaultProjectFake := &lxdfakes.FakeInstanceServer{}
+	projectFake := &lxdfakes.FakeInstanceServer{}
+
+	fake.GetProjectReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Project not found"))
+	fake.UseProjectStub = func(name string) lxd.InstanceServer {
+		if name == defaultProject {
+			return defaultProjectFake
+		}
+
+		return projectFake
+	}
+
+	defaultProfile := &api.Profile{Name: "default"}
+	defaultProfile.Devices = map[string]map[string]string{"root": {"type": "disk", "path": "/", "pool": "default"}}
+	defaultProjectFake.GetProfileReturns(defaultProfile, "", nil)
+	projectFake.GetProfileReturns(&api.Profile{Name: "default"}, "etag", nil)
+
+	err := ensureProject(fake, "lxe", projectFeatures, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.CreateProjectCallCount())
+	project := fake.CreateProjectArgsForCall(0)
+	assert.Equal(t, "lxe", project.Name)
+	assert.Equal(t, "true", project.Config["features.profiles"])
+
+	assert.Equal(t, 1, projectFake.UpdateProfileCallCount())
+	name, profile, etag := projectFake.UpdateProfileArgsForCall(0)
+	assert.Equal(t, "default", name)
+	assert.Equal(t, defaultProfile.Devices, profile.Devices)
+	assert.Equal(t, "etag", etag)
+}
+
+func Test_ensureProject_Profiles(t *testing.T) {
+	t.Parallel()
+
+	_, fake := testClient()
+	defaultProjectFake := &lxdfakes.FakeInstanceServer{}
+	projectFake := &lxdfakes.FakeInstanceServer{}
+
+	fake.GetProjectReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Project not found"))
+	fake.UseProjectStub = func(name string) lxd.InstanceServer {
+		if name == defaultProject {
+			return defaultProjectFake
+		}
+
+		return projectFake
+	}
+
+	notFound := api.StatusErrorf(http.StatusNotFound, "Profile not found")
+
+	defaultProjectFake.GetProfileStub = func(name string) (*api.Profile, string, error) {
+		if name == "missing" {
+			return nil, "", notFound
+		}
+
+		profile := &api.Profile{Name: name}
+		profile.Config = map[string]string{"limits.cpu": name}
+
+		return profile, "", nil
+	}
+	projectFake.GetProfileStub = func(name string) (*api.Profile, string, error) {
+		if name == "default" || name == "existing" {
+			return &api.Profile{Name: name}, "etag", nil
+		}
+
+		return nil, "", notFound
+	}
+
+	err := ensureProject(fake, "lxe", projectFeatures, []string{"default", "global", "existing", "missing", "handler"})
+	assert.NoError(t, err)
+
+	// only the default profile is updated, the missing ones are created from the default project
+	assert.Equal(t, 1, projectFake.UpdateProfileCallCount())
+	assert.Equal(t, 2, projectFake.CreateProfileCallCount())
+
+	for i, name := range []string{"global", "handler"} {
+		profile := projectFake.CreateProfileArgsForCall(i)
+		assert.Equal(t, name, profile.Name)
+		assert.Equal(t, name, profile.Config["limits.cpu"])
+	}
+}
+
+func Test_ensureProject_ProfilesOfExistingProject(t *testing.T) {
+	t.Parallel()
+
+	_, fake := testClient()
+	defaultProjectFake := &lxdfakes.FakeInstanceServer{}
+	projectFake := &lxdfakes.FakeInstanceServer{}
+
+	fake.GetProjectReturns(&api.Project{Name: "lxe", ProjectPut: api.ProjectPut{Config: projectFeatures}}, "", nil)
+	fake.UseProjectStub = func(name string) lxd.InstanceServer {
+		if name == defaultProject {
+			return defaultProjectFake
+		}
+
+		return projectFake
+	}
+
+	defaultProjectFake.GetProfileReturns(&api.Profile{Name: "added"}, "", nil)
+	projectFake.GetProfileReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Profile not found"))
+
+	err := ensureProject(fake, "lxe", projectFeatures, []string{"added"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fake.CreateProjectCallCount())
+	assert.Equal(t, 1, projectFake.CreateProfileCallCount())
+	assert.Equal(t, "added", projectFake.CreateProfileArgsForCall(0).Name)
+}
+
+func TestMigrationWorkspace_ensureProject(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+	c.conn.Project = "lxe"
+	source := &lxdfakes.FakeInstanceServer{}
+	fake.UseProjectReturns(source)
+
+	profile := api.Profile{Name: "sandbox"}
+	profile.Config = map[string]string{cfgIsCRI: "true"}
+	source.GetProfilesReturns([]api.Profile{profile, {Name: "default"}}, nil)
+	fake.GetProfileReturns(nil, "", api.StatusErrorf(http.StatusNotFound, "Profile not found"))
+
+	running := api.Instance{Name: "running", StatusCode: api.Running}
+	running.Config = map[string]string{cfgIsCRI: "true"}
+	stopped := api.Instance{Name: "stopped", StatusCode: api.Stopped}
+	stopped.Config = map[string]string{cfgIsCRI: "true"}
+	source.GetInstancesReturns([]api.Instance{running, stopped, {Name: "handmade"}}, nil)
+
+	op := &lxdfakes.FakeOperation{}
+	source.UpdateInstanceStateReturns(op, nil)
+	source.MigrateInstanceReturns(op, nil)
+	fake.UpdateInstanceStateReturns(op, nil)
+
+	err := NewMigrationWorkspace(c).ensureProject(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, fake.CreateProfileCallCount())
+	assert.Equal(t, "sandbox", fake.CreateProfileArgsForCall(0).Name)
+
+	assert.Equal(t, 2, source.MigrateInstanceCallCount())
+	name, post := source.MigrateInstanceArgsForCall(0)
+	assert.Equal(t, "running", name)
+	assert.Equal(t, "lxe", post.Project)
+
+	// only the running container is stopped and started again
+	assert.Equal(t, 1, source.UpdateInstanceStateCallCount())
+	assert.Equal(t, 1, fake.UpdateInstanceStateCallCount())
+
+	assert.Equal(t, 1, source.DeleteProfileCallCount())
+	assert.Equal(t, "sandbox", source.DeleteProfileArgsForCall(0))
+}
+
+func TestMigrationWorkspace_ensureProject_Default(t *testing.T) {
+	t.Parallel()
+
+	c, fake := testClient()
+
+	err := NewMigrationWorkspace(c).ensureProject(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fake.UseProjectCallCount())
+}
@@ -0,0 +1,74 @@
+package lxf
+
+import (
+	"context"
+	"time"
+)
+
+// Reconcile corrects the recorded state of the container if it differs from the one in LXD, like when the container
+// was started or stopped while LXE wasn't running and the lifecycle event was missed. It reports whether the
+// container was started without LXE.
+func (c *Container) Reconcile(ctx context.Context) (bool, error) {
+	stale := c.staleState()
+	if stale == "" {
+		return false, nil
+	}
+
+	log := c.log(ctx).WithField("state", c.StateName)
+
+	err := c.Update(ctx, func(c *Container) error {
+		stale = c.staleState()
+		c.fixState(time.Now())
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if stale != "" {
+		log.WithField("reason", stale).Warn("corrected recorded state of container")
+	}
+
+	return stale == staleStarted, nil
+}
+
+const (
+	staleStarted = "started without LXE"
+	staleStopped = "stopped without LXE"
+)
+
+// staleState describes how the recorded state of the container differs from the one in LXD, empty if it doesn't
+func (c *Container) staleState() string {
+	recordedRunning := c.StartedAt.After(c.FinishedAt)
+
+	switch c.StateName { // nolint: exhaustive
+	case ContainerStateRunning:
+		if !recordedRunning || c.Config[cfgState] == ContainerStateCreated.String() {
+			return staleStarted
+		}
+	case ContainerStateExited:
+		if recordedRunning {
+			return staleStopped
+		}
+	}
+
+	return ""
+}
+
+// fixState records the state of the container in LXD as if it changed at now
+func (c *Container) fixState(now time.Time) {
+	switch c.staleState() {
+	case staleStarted:
+		delete(c.Config, cfgState)
+		c.StartedAt = now
+		// the operation of a job belongs to the previous run
+		c.JobOperation = ""
+	case staleStopped:
+		if c.Job {
+			c.ExitCode = CodeJobTerminated
+		}
+
+		c.FinishedAt = now
+	}
+}
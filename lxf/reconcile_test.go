@@ -0,0 +1,65 @@
+package lxf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_fixState(t *testing.T) {
+	t.Parallel()
+
+	before := time.Unix(100, 0)
+	after := time.Unix(200, 0)
+	now := time.Unix(300, 0)
+
+	tests := []struct {
+		name        string
+		state       ContainerStateName
+		created     bool
+		job         bool
+		started     time.Time
+		finished    time.Time
+		expStale    string
+		expStarted  time.Time
+		expFinished time.Time
+		expCode     int32
+	}{
+		{"running", ContainerStateRunning, false, false, after, before, "", after, before, 0},
+		{"exited", ContainerStateExited, false, false, before, after, "", before, after, 0},
+		{"created", ContainerStateCreated, true, false, time.Time{}, time.Time{}, "", time.Time{}, time.Time{}, 0},
+		{"autostarted created", ContainerStateRunning, true, false, time.Time{}, time.Time{}, staleStarted, now, time.Time{}, 0},
+		{"autostarted exited", ContainerStateRunning, false, false, before, after, staleStarted, now, after, 0},
+		{"stopped running", ContainerStateExited, false, false, after, before, staleStopped, after, now, 0},
+		{"stopped running job", ContainerStateExited, false, true, after, before, staleStopped, after, now, CodeJobTerminated},
+		{"unknown", ContainerStateUnknown, false, false, after, before, "", after, before, 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Container{StateName: tt.state, StartedAt: tt.started, FinishedAt: tt.finished, Job: tt.job, JobOperation: "op1"}
+			c.Config = map[string]string{}
+
+			if tt.created {
+				c.Config[cfgState] = ContainerStateCreated.String()
+			}
+
+			assert.Equal(t, tt.expStale, c.staleState())
+
+			c.fixState(now)
+			assert.Equal(t, tt.expStarted, c.StartedAt)
+			assert.Equal(t, tt.expFinished, c.FinishedAt)
+			assert.Equal(t, tt.expCode, c.ExitCode)
+			assert.Empty(t, c.staleState())
+
+			if tt.expStale == staleStarted {
+				assert.NotContains(t, c.Config, cfgState)
+				assert.Empty(t, c.JobOperation)
+			}
+		})
+	}
+}
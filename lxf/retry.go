@@ -0,0 +1,119 @@
+package lxf
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	retryAttempts   = 5
+	retryMinBackoff = 50 * time.Millisecond
+	retryMaxBackoff = 2 * time.Second
+)
+
+// isOutdatedError reports whether an update was rejected because the object has changed since its ETag was read
+func isOutdatedError(err error) bool {
+	return api.StatusErrorCheck(err, http.StatusPreconditionFailed)
+}
+
+// isTransientError reports whether the request might succeed if it's repeated, like when the connection was reset or
+// LXD had an internal error
+func isTransientError(err error) bool {
+	if isConnectionError(err) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	status, ok := api.StatusErrorMatch(err)
+
+	return ok && status >= http.StatusInternalServerError
+}
+
+// isRetryableUpdateError reports whether a read-modify-write cycle should be repeated
+func isRetryableUpdateError(err error) bool {
+	return isOutdatedError(err) || isTransientError(err)
+}
+
+// retry calls fn until it succeeds, returns an error which isn't retryable, the attempts are exhausted or the context
+// is done. Between the attempts it waits an exponentially growing backoff with jitter, so concurrent writers of the
+// same object don't collide again.
+func retry(ctx context.Context, log *logrus.Entry, retryable func(error) bool, fn func() error) error {
+	backoff := retryMinBackoff
+
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || !retryable(err) || attempt >= retryAttempts {
+			return err
+		}
+
+		// full jitter between half and the whole backoff
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)) // nolint: gosec
+
+		log.WithError(err).WithFields(logrus.Fields{"attempt": attempt, "retry": wait}).Warn("retrying lxd request")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > retryMaxBackoff {
+			backoff = retryMaxBackoff
+		}
+	}
+}
+
+// objectLocks serializes updates of the same sandbox or container within LXE
+type objectLocks struct {
+	mu    sync.Mutex
+	locks map[string]*objectLock
+}
+
+type objectLock struct {
+	sync.Mutex
+	// users holding or waiting for the lock, it's removed when there are none
+	users int
+}
+
+func newObjectLocks() *objectLocks {
+	return &objectLocks{locks: map[string]*objectLock{}}
+}
+
+// lock the object with the id and return the function to unlock it again
+func (o *objectLocks) lock(id string) func() {
+	o.mu.Lock()
+
+	l, has := o.locks[id]
+	if !has {
+		l = &objectLock{}
+		o.locks[id] = l
+	}
+
+	l.users++
+	o.mu.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		l.users--
+		if l.users == 0 {
+			delete(o.locks, id)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package lxf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/lxc/lxd/shared/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isRetryableUpdateError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		err       error
+		outdated  bool
+		transient bool
+	}{
+		{"precondition failed", api.StatusErrorf(http.StatusPreconditionFailed, "ETag doesn't match"), true, false},
+		{"internal error", api.StatusErrorf(http.StatusInternalServerError, "database is locked"), false, true},
+		{"not found", api.StatusErrorf(http.StatusNotFound, "Profile not found"), false, false},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), false, true},
+		{"other", errors.New("other"), false, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.outdated, isOutdatedError(tt.err))
+			assert.Equal(t, tt.transient, isTransientError(tt.err))
+			assert.Equal(t, tt.outdated || tt.transient, isRetryableUpdateError(tt.err))
+		})
+	}
+}
+
+func Test_retry(t *testing.T) {
+	t.Parallel()
+
+	conflict := api.StatusErrorf(http.StatusPreconditionFailed, "ETag doesn't match")
+	other := errors.New("other")
+
+	tests := []struct {
+		name     string
+		errs     []error
+		expErr   error
+		expCalls int
+	}{
+		{"success", []error{nil}, nil, 1},
+		{"conflict then success", []error{conflict, conflict, nil}, nil, 3},
+		{"not retryable", []error{other, nil}, other, 1},
+		{"gives up", []error{conflict, conflict, conflict, conflict, conflict, nil}, conflict, retryAttempts},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			err := retry(context.Background(), log.WithField("test", tt.name), isRetryableUpdateError, func() error {
+				calls++
+
+				return tt.errs[calls-1]
+			})
+			assert.Equal(t, tt.expErr, err)
+			assert.Equal(t, tt.expCalls, calls)
+		})
+	}
+}
+
+func Test_retry_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := retry(ctx, log.WithField("test", "cancelled"), isRetryableUpdateError, func() error {
+		calls++
+
+		return api.StatusErrorf(http.StatusPreconditionFailed, "ETag doesn't match")
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
+
+func Test_objectLocks(t *testing.T) {
+	t.Parallel()
+
+	locks := newObjectLocks()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		running int
+		maxRun  int
+	)
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock := locks.lock("foo")
+			defer unlock()
+
+			mu.Lock()
+			running++
+			if running > maxRun {
+				maxRun = running
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+
+	// other objects are not blocked
+	unlock := locks.lock("bar")
+	unlock()
+
+	wg.Wait()
+
+	assert.Equal(t, 1, maxRun)
+	assert.Empty(t, locks.locks)
+}
+
+func TestSandbox_Update_Conflict(t *testing.T) {
+	t.Parallel()
+
+	client, fake := testClient()
+	fake.GetProfileReturns(basicProfile("foo"), "etag", nil)
+	fake.UpdateProfileReturnsOnCall(0, api.StatusErrorf(http.StatusPreconditionFailed, "ETag doesn't match"))
+	fake.UpdateProfileReturnsOnCall(1, nil)
+
+	sb, err := client.GetSandbox(context.Background(), "foo")
+	assert.NoError(t, err)
+
+	err = sb.Update(context.Background(), func(sb *Sandbox) error {
+		sb.Hostname = "bar"
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fake.UpdateProfileCallCount())
+
+	_, put, etag := fake.UpdateProfileArgsForCall(1)
+	assert.Equal(t, "etag", etag)
+	assert.Equal(t, "bar", put.Config[cfgHostname])
+}
@@ -1,6 +1,7 @@
 package lxf
 
 import (
+	"context"
 	"crypto/md5" // nolint: gosec
 	"fmt"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"github.com/automaticserver/lxe/network/cloudinit"
 	"github.com/ghodss/yaml"
 	"github.com/lxc/lxd/shared/api"
+	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/uuid"
 )
 
@@ -22,6 +24,7 @@ const (
 
 	cfgHostname                 = "user.host_name"
 	cfgLogDirectory             = "user.log_directory"
+	cfgRuntimeHandler           = "user.runtime_handler"
 	cfgCreatedAt                = "user.created_at"
 	cfgNetworkConfig            = "user.networkconfig"
 	cfgNetworkConfigNameservers = cfgNetworkConfig + ".nameservers"
@@ -38,6 +41,7 @@ var (
 			cfgLogDirectory,
 			cfgState,
 			cfgHostname,
+			cfgRuntimeHandler,
 			cfgCloudInitNetworkConfig,
 			cfgCloudInitVendorData,
 			cfgNetworkConfigModeData,
@@ -71,6 +75,8 @@ type Sandbox struct {
 	State SandboxState
 	// LogDirectory TODO, to be implemented?
 	LogDirectory string
+	// RuntimeHandler selected by the runtime class of the pod, empty for the default handler
+	RuntimeHandler string
 	// CloudInitNetworkConfigEntries to set
 	CloudInitNetworkConfigEntries []cloudinit.NetworkConfigEntryPhysical
 
@@ -149,10 +155,10 @@ func getSandboxState(str string) SandboxState {
 // Containers looks up all assigned containers
 // Implemented as lazy loading, and returns same result if already looked up
 // Not thread safe! But it's expected the pointers stay in the same routine
-func (s *Sandbox) Containers() ([]*Container, error) {
+func (s *Sandbox) Containers(ctx context.Context) ([]*Container, error) {
 	var err error
 	if s.containers == nil {
-		s.containers, err = s.getContainers()
+		s.containers, err = s.getContainers(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -161,11 +167,11 @@ func (s *Sandbox) Containers() ([]*Container, error) {
 	return s.containers, nil
 }
 
-func (s *Sandbox) getContainers() ([]*Container, error) {
+func (s *Sandbox) getContainers(ctx context.Context) ([]*Container, error) {
 	cl := []*Container{}
 
 	for _, cntName := range s.UsedBy {
-		c, err := s.client.GetContainer(cntName)
+		c, err := s.client.GetContainer(ctx, cntName)
 		if err != nil {
 			return nil, err
 		}
@@ -177,8 +183,8 @@ func (s *Sandbox) getContainers() ([]*Container, error) {
 }
 
 // refresh loads the profile again from LXD with data and ETag
-func (s *Sandbox) refresh() error {
-	r, err := s.client.GetSandbox(s.ID)
+func (s *Sandbox) refresh(ctx context.Context) error {
+	r, err := s.client.GetSandbox(ctx, s.ID)
 	if err != nil {
 		return err
 	}
@@ -188,47 +194,100 @@ func (s *Sandbox) refresh() error {
 	return nil
 }
 
-// Apply will save the changes of a sandbox
-func (s *Sandbox) Apply() error {
+// Apply will save the changes of a sandbox. Transient errors of LXD are retried for existing sandboxes, but an
+// outdated ETag is not, use Update for that.
+func (s *Sandbox) Apply(ctx context.Context) error {
 	// A new sandbox gets also some default values
 	// except ID, which is generated inline in unexported method apply()
 	if s.ID == "" {
 		s.State = SandboxReady
 		s.CreatedAt = time.Now()
+
+		err := s.save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return s.refresh(ctx)
 	}
 
-	// Always stop inheriting default eth0 device
-	s.Devices.Upsert(&device.None{
-		KeyName: lxdInitDefaultNicName,
-	})
+	unlock := s.client.locks.lock(s.ID)
+	defer unlock()
 
-	err := s.apply()
+	err := retry(ctx, s.log(ctx), isTransientError, func() error {
+		return s.save(ctx)
+	})
 	if err != nil {
 		return err
 	}
 
-	return s.refresh()
+	return s.refresh(ctx)
+}
+
+// Update loads the sandbox again, changes it with mutate and saves it. If the sandbox was changed in the meantime or
+// LXD had a transient error, this is repeated. Updates of the same sandbox are serialized.
+func (s *Sandbox) Update(ctx context.Context, mutate func(*Sandbox) error) error {
+	unlock := s.client.locks.lock(s.ID)
+	defer unlock()
+
+	return retry(ctx, s.log(ctx), isRetryableUpdateError, func() error {
+		err := s.refresh(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = mutate(s)
+		if err != nil {
+			return err
+		}
+
+		err = s.save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return s.refresh(ctx)
+	})
 }
 
 // Stop set the sandbox state to SandboxNotReady
-func (s *Sandbox) Stop() error {
-	s.State = SandboxNotReady
+func (s *Sandbox) Stop(ctx context.Context) error {
+	return s.Update(ctx, func(s *Sandbox) error {
+		s.State = SandboxNotReady
+
+		return nil
+	})
+}
+
+// save the sandbox with the devices every sandbox has
+func (s *Sandbox) save(ctx context.Context) error {
+	// Always stop inheriting default eth0 device
+	s.Devices.Upsert(&device.None{
+		KeyName: lxdInitDefaultNicName,
+	})
+
+	return s.apply(ctx)
+}
 
-	return s.apply()
+func (s *Sandbox) log(ctx context.Context) *logrus.Entry {
+	return log.WithContext(ctx).WithField("podid", s.ID)
 }
 
 // Delete will delete the given sandbox, returns nil when sandbox is already deleted
-func (s *Sandbox) Delete() error {
-	err := s.client.server.DeleteProfile(s.ID)
+func (s *Sandbox) Delete(ctx context.Context) error {
+	err := s.client.projectServer(s.project).DeleteProfile(s.ID)
 	if err != nil {
 		return err
 	}
 
+	s.client.projectIndex.delete(s.ID)
+	s.client.cache.removeProfile(s.project, s.ID)
+
 	return nil
 }
 
 // apply saves the changes to LXD
-func (s *Sandbox) apply() error {
+func (s *Sandbox) apply(ctx context.Context) error {
 	config, err := makeSandboxConfig(s)
 	if err != nil {
 		return err
@@ -242,12 +301,24 @@ func (s *Sandbox) apply() error {
 	}
 
 	if s.ID == "" { // profile has to be created
+		s.project, err = s.client.namespaceProject(s.Metadata.Namespace)
+		if err != nil {
+			return err
+		}
+
 		s.ID = s.CreateID()
 
-		return s.client.server.CreateProfile(api.ProfilesPost{
+		err = s.client.projectServer(s.project).CreateProfile(api.ProfilesPost{
 			Name:       s.ID,
 			ProfilePut: profile,
 		})
+		if err != nil {
+			return err
+		}
+
+		s.client.indexObject(s.ID, s.project)
+
+		return nil
 	}
 
 	// else profile has to be updated
@@ -255,7 +326,11 @@ func (s *Sandbox) apply() error {
 		return fmt.Errorf("update profile not allowed: %w", ErrMissingETag)
 	}
 
-	err = s.client.server.UpdateProfile(s.ID, profile, s.ETag)
+	err = s.client.projectServer(s.project).UpdateProfile(s.ID, profile, s.ETag)
+
+	// the cached etag is outdated now, or already was if the update failed
+	s.client.cache.invalidateProfile(s.project, s.ID)
+
 	if err != nil {
 		return err
 	}
@@ -281,6 +356,7 @@ func makeSandboxConfig(s *Sandbox) (map[string]string, error) { // nolint: cyclo
 		cfgMetaUID:                  s.Metadata.UID,
 		cfgHostname:                 s.Hostname,
 		cfgLogDirectory:             s.LogDirectory,
+		cfgRuntimeHandler:           s.RuntimeHandler,
 		cfgNetworkConfigNameservers: strings.Join(s.NetworkConfig.Nameservers, ","),
 		cfgNetworkConfigSearches:    strings.Join(s.NetworkConfig.Searches, ","),
 		cfgNetworkConfigMode:        s.NetworkConfig.Mode.String(),
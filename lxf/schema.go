@@ -2,6 +2,7 @@
 package lxf
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -46,13 +47,13 @@ func IsSchemaCurrent(i interface{}) bool {
 	)
 
 	switch o := i.(type) {
-	case api.Container:
+	case api.Instance:
 		if val, has = o.Config[cfgSchema]; !has {
 			return false
 		}
 
 		return val == SchemaVersionContainer
-	case *api.Container:
+	case *api.Instance:
 		return IsSchemaCurrent(*o)
 	case api.Profile:
 		if val, has = o.Config[cfgSchema]; !has {
@@ -73,9 +74,15 @@ func IsSchemaCurrent(i interface{}) bool {
 	}
 }
 
-// Ensure applies all migration steps from detected schema to current schema
-func (m *MigrationWorkspace) Ensure() error { // nolint: gocognit, cyclop
-	profiles, err := m.lxf.server.GetProfiles()
+// Ensure moves the objects into the dedicated project and applies all migration steps from detected schema to current
+// schema
+func (m *MigrationWorkspace) Ensure(ctx context.Context) error { // nolint: gocognit, cyclop
+	err := m.ensureProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	profiles, err := m.lxf.currentServer().GetProfiles()
 	if err != nil {
 		return err
 	}
@@ -110,7 +117,7 @@ func (m *MigrationWorkspace) Ensure() error { // nolint: gocognit, cyclop
 		if counter > 0 {
 			anyChanges = true
 
-			err = m.lxf.server.UpdateProfile(p.Name, p.Writable(), "")
+			err = m.lxf.currentServer().UpdateProfile(p.Name, p.Writable(), "")
 			if err != nil {
 				return err
 			}
@@ -119,7 +126,7 @@ func (m *MigrationWorkspace) Ensure() error { // nolint: gocognit, cyclop
 
 	var etag string
 
-	containers, err := m.lxf.server.GetContainers()
+	containers, err := m.lxf.currentServer().GetInstances(api.InstanceTypeAny)
 	if err != nil {
 		return err
 	}
@@ -160,7 +167,7 @@ func (m *MigrationWorkspace) Ensure() error { // nolint: gocognit, cyclop
 		if counter > 0 {
 			anyChanges = true
 
-			err := m.lxf.opwait.UpdateContainer(c.Name, c.Writable(), etag)
+			err := m.lxf.currentOpwait().UpdateInstance(ctx, c.Name, c.Writable(), etag)
 			if err != nil {
 				return err
 			}
@@ -211,7 +218,7 @@ func (m *MigrationWorkspace) ensureProfileZeroThree(p *api.Profile) bool {
 	return false
 }
 
-func (m *MigrationWorkspace) ensureContainerZeroOne(c *api.Container) bool {
+func (m *MigrationWorkspace) ensureContainerZeroOne(c *api.Instance) bool {
 	if c.Config[cfgSchema] == "" {
 		c.Config[cfgSchema] = zeroOne
 
@@ -223,7 +230,7 @@ func (m *MigrationWorkspace) ensureContainerZeroOne(c *api.Container) bool {
 
 // user.is_cri_container has moved to user.cri
 // user.containerName has moved to user.metadata.Name
-func (m *MigrationWorkspace) ensureContainerZeroTwo(c *api.Container) bool {
+func (m *MigrationWorkspace) ensureContainerZeroTwo(c *api.Instance) bool {
 	if c.Config[cfgSchema] == zeroOne {
 		c.Config[cfgIsCRI] = c.Config[cfgOldIsContainer]
 		c.Config[cfgMetaName] = c.Config[cfgOldContainerName]
@@ -238,7 +245,7 @@ func (m *MigrationWorkspace) ensureContainerZeroTwo(c *api.Container) bool {
 // createdDate can be missing
 // autostart can be missing
 // cleanup unused keys
-func (m *MigrationWorkspace) ensureContainerZeroThree(c *api.Container) bool {
+func (m *MigrationWorkspace) ensureContainerZeroThree(c *api.Instance) bool {
 	if c.Config[cfgSchema] == zeroTwo {
 		delete(c.Config, cfgOldIsContainer)
 		delete(c.Config, cfgOldContainerName)
@@ -270,7 +277,7 @@ func (m *MigrationWorkspace) ensureContainerZeroThree(c *api.Container) bool {
 // boot.autostart is not managed by lxe anymore, keep field as-is
 // WARNING: intentionally changed migration to 0.3 to not force-setting that field if
 // someone is coming from 0.2 or below
-func (m *MigrationWorkspace) ensureContainerZeroFour(c *api.Container) bool {
+func (m *MigrationWorkspace) ensureContainerZeroFour(c *api.Instance) bool {
 	if c.Config[cfgSchema] == zeroThree {
 		c.Config[cfgSchema] = zeroFour
 
@@ -282,7 +289,7 @@ func (m *MigrationWorkspace) ensureContainerZeroFour(c *api.Container) bool {
 
 // Implemented variable length of profiles. The order of profiles in schema <= 0.4 was wrong.
 // Move the first profile, which was the sandbox, to the last position, otherwise preserve position
-func (m *MigrationWorkspace) ensureContainerZeroFive(c *api.Container) bool {
+func (m *MigrationWorkspace) ensureContainerZeroFive(c *api.Instance) bool {
 	if c.Config[cfgSchema] == zeroFour {
 		c.Profiles = append(c.Profiles[1:], c.Profiles[0])
 		c.Config[cfgSchema] = zeroFive
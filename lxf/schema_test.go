@@ -8,8 +8,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func getSchemaContainer(schema string) api.Container {
-	c := api.Container{}
+func getSchemaContainer(schema string) api.Instance {
+	c := api.Instance{}
 	c.Config = make(map[string]string)
 
 	if schema != "" {
@@ -75,7 +75,7 @@ func TestIsSchemaPointer(t *testing.T) {
 	assert.Equal(t, true, p)
 }
 
-func satisfyContainerSchema(ct *api.Container) *api.Container {
+func satisfyContainerSchema(ct *api.Instance) *api.Instance {
 	ct.Config[cfgSchema] = SchemaVersionContainer
 
 	return ct
@@ -7,8 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"os"
+	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/containernetworking/cni/libcni"
@@ -57,6 +58,10 @@ type cniPlugin struct {
 	noopPlugin // every method not implemented is noop
 	cni        libcni.CNI
 	conf       ConfCNI
+	// createNetns and removeNetns manage the network namespaces of the pods
+	createNetns func(path string) error
+	removeNetns func(path string) error
+	isNetns     func(path string) bool
 }
 
 // InitPluginCNI instantiates the cni plugin using the provided config
@@ -66,8 +71,11 @@ func InitPluginCNI(conf ConfCNI) (*cniPlugin, error) { // nolint: golint, revive
 	exec := &invoke.DefaultExec{RawExec: &invoke.RawExec{Stderr: conf.OutputWriter}}
 
 	return &cniPlugin{
-		cni:  libcni.NewCNIConfig([]string{conf.BinPath}, exec),
-		conf: conf,
+		cni:         libcni.NewCNIConfig([]string{conf.BinPath}, exec),
+		conf:        conf,
+		createNetns: createNetns,
+		removeNetns: removeNetns,
+		isNetns:     IsNetns,
 	}, nil
 }
 
@@ -85,6 +93,7 @@ func (p *cniPlugin) PodNetwork(id string, annotations map[string]string) (PodNet
 		netList:     netList,
 		runtimeConf: runtimeConf,
 		annotations: annotations,
+		netns:       filepath.Join(p.conf.NetnsPath, id),
 	}, nil
 }
 
@@ -179,6 +188,8 @@ type cniPodNetwork struct {
 	netList        *libcni.NetworkConfigList
 	runtimeConf    *libcni.RuntimeConf
 	annotations    map[string]string
+	// netns is the path of the network namespace of the pod, all containers of the pod join it
+	netns string
 }
 
 // ContainerNetwork enters a container network environment context
@@ -190,8 +201,14 @@ func (s *cniPodNetwork) ContainerNetwork(id string, annotations map[string]strin
 	}, nil
 }
 
-// Status reports IP and any error with the network of that pod
+// Status reports IP and any error with the network of that pod. The network is lost if its namespace is gone, e.g.
+// after a reboot.
 func (s *cniPodNetwork) Status(ctx context.Context, prop *PropertiesRunning) (*Status, error) {
+	// pods created before the network namespace was kept didn't record it
+	if netns := prop.Data["netns"]; netns != "" && !s.plugin.isNetns(netns) {
+		return nil, fmt.Errorf("%w: %s", ErrNetnsLost, netns)
+	}
+
 	ips, err := s.ips([]byte(prop.Data["result"]))
 	if err != nil {
 		return nil, err
@@ -200,6 +217,41 @@ func (s *cniPodNetwork) Status(ctx context.Context, prop *PropertiesRunning) (*S
 	return &Status{IPs: ips}, nil
 }
 
+// WhenStarted creates the network namespace of the pod and sets up the network in it. The containers join the
+// namespace when they are started.
+func (s *cniPodNetwork) WhenStarted(ctx context.Context, prop *PropertiesRunning) (*Result, error) {
+	err := s.plugin.createNetns(s.netns)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.setup(ctx, s.netns)
+	if err != nil {
+		// don't leave a namespace without network behind
+		_ = s.plugin.removeNetns(s.netns)
+
+		return nil, err
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Data: map[string]string{"result": string(b), "netns": s.netns}, NetNamespace: s.netns}, nil
+}
+
+// WhenStopped tears down the network and removes the network namespace of the pod
+func (s *cniPodNetwork) WhenStopped(ctx context.Context, _ *Properties) error {
+	return s.teardown(ctx)
+}
+
+// WhenDeleted tears down the network and removes the network namespace of the pod, if not already done when the pod
+// was stopped
+func (s *cniPodNetwork) WhenDeleted(ctx context.Context, _ *Properties) error {
+	return s.teardown(ctx)
+}
+
 // Setup creates the network interface for the provided netfile
 func (s *cniPodNetwork) setup(ctx context.Context, netfile string) (types.Result, error) {
 	s.runtimeConf.NetNS = netfile
@@ -213,11 +265,21 @@ func (s *cniPodNetwork) setup(ctx context.Context, netfile string) (types.Result
 	return current.NewResultFromResult(prevResult)
 }
 
-// Teardown removes the network compeletely as good as possible
+// Teardown removes the network and the network namespace compeletely as good as possible
 func (s *cniPodNetwork) teardown(ctx context.Context) error {
 	s.runtimeConf.NetNS = ""
+	if s.plugin.isNetns(s.netns) {
+		s.runtimeConf.NetNS = s.netns
+	}
+
+	err := s.plugin.cni.DelNetworkList(ctx, s.netList, s.runtimeConf)
 
-	return s.plugin.cni.DelNetworkList(ctx, s.netList, s.runtimeConf)
+	rmErr := s.plugin.removeNetns(s.netns)
+	if err == nil {
+		err = rmErr
+	}
+
+	return err
 }
 
 // Get ips of that result
@@ -248,7 +310,8 @@ func (s *cniPodNetwork) ips(previousresult []byte) ([]net.IP, error) {
 	return []net.IP{result.IPs[0].Address.IP}, nil
 }
 
-// cniContainerNetwork is a container network environment context
+// cniContainerNetwork is a container network environment context. The containers join the network namespace of the
+// pod, so there's nothing to set up per container.
 type cniContainerNetwork struct {
 	noopContainerNetwork // every method not implemented is noop
 	pod                  *cniPodNetwork
@@ -256,25 +319,61 @@ type cniContainerNetwork struct {
 	annotations          map[string]string
 }
 
-// WhenStarted is called when the container is started.
-func (c *cniContainerNetwork) WhenStarted(ctx context.Context, prop *PropertiesRunning) (*Result, error) {
-	// TODO: As long as we haven't figured out to do 1:n podnetwork:container this method goes up to pod
-	result, err := c.pod.setup(ctx, fmt.Sprintf("/proc/%s/ns/net", strconv.FormatInt(prop.Pid, 10)))
+// leftoverNetns is the kind of a network namespace of a pod which doesn't exist anymore
+const leftoverNetns = "netns"
+
+// Leftovers returns the network namespaces of pods which don't exist anymore. As other tools keep network namespaces
+// in the same path, only the ones with a cached CNI result for the pod are considered.
+func (p *cniPlugin) Leftovers(ctx context.Context, pods map[string]bool) ([]Leftover, error) {
+	entries, err := os.ReadDir(p.conf.NetnsPath)
 	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Leftover{}, nil
+		}
+
 		return nil, err
 	}
 
-	b, err := json.Marshal(result)
+	netList, _, err := p.getCNINetworkConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	return &Result{Data: map[string]string{"result": string(b)}}, nil
+	leftovers := []Leftover{}
+
+	for _, e := range entries {
+		if pods[e.Name()] || !p.isNetns(filepath.Join(p.conf.NetnsPath, e.Name())) {
+			continue
+		}
+
+		result, err := p.cni.GetNetworkListCachedResult(netList, p.getCNIRuntimeConf(e.Name()))
+		if err != nil || result == nil {
+			continue
+		}
+
+		leftover := Leftover{Kind: leftoverNetns, Name: e.Name(), Removable: true}
+
+		info, err := e.Info()
+		if err == nil {
+			leftover.ModifiedAt = info.ModTime()
+		}
+
+		leftovers = append(leftovers, leftover)
+	}
+
+	return leftovers, nil
 }
 
-// WhenDeleted is called when the container is deleted. If tearing down here, must tear down as good as possible. Must
-// tear down here if not implemented for WhenStopped. If an error is returned it will only be logged
-func (c *cniContainerNetwork) WhenDeleted(ctx context.Context, prop *Properties) error {
-	// TODO: As long as we haven't figured out to do 1:n podnetwork:container this method goes up to pod
-	return c.pod.teardown(ctx)
+// RemoveLeftover tears down the network of the pod and removes its network namespace
+func (p *cniPlugin) RemoveLeftover(ctx context.Context, leftover Leftover) error {
+	if leftover.Kind != leftoverNetns {
+		return fmt.Errorf("%w: leftover %s", ErrNotSupported, leftover.Kind)
+	}
+
+	podNet, err := p.PodNetwork(leftover.Name, nil)
+	if err != nil {
+		return err
+	}
+
+	return podNet.(*cniPodNetwork).teardown(ctx) // nolint: forcetypeassert
 }
@@ -2,12 +2,14 @@
 package network
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 
 	libcnifake "github.com/automaticserver/lxe/fakes/containernetworking/libcni"
 	"github.com/containernetworking/cni/libcni"
+	"github.com/containernetworking/cni/pkg/types"
 	types020 "github.com/containernetworking/cni/pkg/types/020"
 	types040 "github.com/containernetworking/cni/pkg/types/040"
 	current "github.com/containernetworking/cni/pkg/types/100"
@@ -16,9 +18,10 @@ import (
 
 var (
 	// verify interface satisfaction
-	_ Plugin           = &cniPlugin{}
-	_ PodNetwork       = &cniPodNetwork{}
-	_ ContainerNetwork = &cniContainerNetwork{}
+	_ Plugin            = &cniPlugin{}
+	_ PodNetwork        = &cniPodNetwork{}
+	_ ContainerNetwork  = &cniContainerNetwork{}
+	_ LeftoverCollector = &cniPlugin{}
 )
 
 func fakeCNIFiles(t *testing.T) (string, string, string, string) {
@@ -83,6 +86,23 @@ func testCNIPlugin(t *testing.T) (*cniPlugin, *libcnifake.FakeCNI, string) {
 			ConfPath:  confPath,
 			NetnsPath: netnsPath,
 		},
+		// creating network namespaces requires root, so only a file is created
+		createNetns: func(path string) error {
+			return os.WriteFile(path, nil, 0600)
+		},
+		removeNetns: func(path string) error {
+			err := os.Remove(path)
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		},
+		isNetns: func(path string) bool {
+			_, err := os.Stat(path)
+
+			return err == nil
+		},
 	}, fake, tmpDir
 }
 
@@ -99,6 +119,7 @@ func Test_cniPlugin_PodNetwork_Simple(t *testing.T) {
 	tPodNet := podNet.(*cniPodNetwork)
 	assert.NotNil(t, tPodNet.netList)
 	assert.NotNil(t, tPodNet.runtimeConf)
+	assert.Equal(t, filepath.Join(plugin.conf.NetnsPath, "foo"), tPodNet.netns)
 }
 
 func Test_cniPlugin_UpdateRuntimeConfig(t *testing.T) {
@@ -135,6 +156,7 @@ func testCNIPodNet(t *testing.T) (*cniPodNetwork, *libcnifake.FakeCNI, string) {
 		plugin:      plugin,
 		netList:     nil,
 		runtimeConf: plugin.getCNIRuntimeConf("foo"),
+		netns:       filepath.Join(plugin.conf.NetnsPath, "foo"),
 	}, fake, tmpDir
 }
 
@@ -165,6 +187,29 @@ func Test_cniPodNetwork_Status_Simple(t *testing.T) {
 	assert.Equal(t, "10.22.0.64", status.IPs[0].String())
 }
 
+func Test_cniPodNetwork_Status_NetnsLost(t *testing.T) {
+	t.Parallel()
+
+	podNet, _, tmpDir := testCNIPodNet(t)
+	defer os.RemoveAll(tmpDir)
+
+	data := map[string]string{
+		"result": `{"cniVersion":"1.0.0","ips":[{"version":"4","interface":2,"address":"10.22.0.64/16","gateway":"10.22.0.1"}]}`,
+		"netns":  podNet.netns,
+	}
+
+	status, err := podNet.Status(ctx, &PropertiesRunning{Properties: Properties{Data: data}})
+	assert.ErrorIs(t, err, ErrNetnsLost)
+	assert.Nil(t, status)
+
+	err = podNet.plugin.createNetns(podNet.netns)
+	assert.NoError(t, err)
+
+	status, err = podNet.Status(ctx, &PropertiesRunning{Properties: Properties{Data: data}})
+	assert.NoError(t, err)
+	assert.Len(t, status.IPs, 1)
+}
+
 func Test_cniPodNetwork_Status_Missing(t *testing.T) {
 	t.Parallel()
 
@@ -268,6 +313,67 @@ func Test_cniPodNetwork_teardown_afterSetup(t *testing.T) {
 	assert.Equal(t, "", argRuntimeConf.NetNS)
 }
 
+func Test_cniPodNetwork_WhenStarted(t *testing.T) {
+	t.Parallel()
+
+	podNet, fake, tmpDir := testCNIPodNet(t)
+	defer os.RemoveAll(tmpDir)
+
+	fake.AddNetworkListReturns(&current.Result{CNIVersion: "1.0.0", IPs: []*current.IPConfig{}}, nil)
+
+	res, err := podNet.WhenStarted(ctx, &PropertiesRunning{Properties: Properties{}, Pid: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.AddNetworkListCallCount())
+	assert.NotEmpty(t, res.Data)
+	assert.Equal(t, podNet.netns, res.NetNamespace)
+	assert.Empty(t, res.Nics)
+	assert.Empty(t, res.NetworkConfigEntries)
+	assert.FileExists(t, podNet.netns)
+
+	_, _, argRuntimeConf := fake.AddNetworkListArgsForCall(0)
+	assert.Equal(t, podNet.netns, argRuntimeConf.NetNS)
+}
+
+func Test_cniPodNetwork_WhenStarted_Error(t *testing.T) {
+	t.Parallel()
+
+	podNet, fake, tmpDir := testCNIPodNet(t)
+	defer os.RemoveAll(tmpDir)
+
+	fake.AddNetworkListReturns(nil, errors.New("failed"))
+
+	res, err := podNet.WhenStarted(ctx, &PropertiesRunning{Properties: Properties{}, Pid: 0})
+	assert.Error(t, err)
+	assert.Nil(t, res)
+	assert.NoFileExists(t, podNet.netns)
+}
+
+func Test_cniPodNetwork_WhenStopped(t *testing.T) {
+	t.Parallel()
+
+	podNet, fake, tmpDir := testCNIPodNet(t)
+	defer os.RemoveAll(tmpDir)
+
+	fake.AddNetworkListReturns(&current.Result{CNIVersion: "1.0.0", IPs: []*current.IPConfig{}}, nil)
+	fake.DelNetworkListReturns(nil)
+
+	_, err := podNet.WhenStarted(ctx, &PropertiesRunning{Properties: Properties{}, Pid: 0})
+	assert.NoError(t, err)
+
+	err = podNet.WhenStopped(ctx, &Properties{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.DelNetworkListCallCount())
+	assert.NoFileExists(t, podNet.netns)
+
+	_, _, argRuntimeConf := fake.DelNetworkListArgsForCall(0)
+	assert.Equal(t, podNet.netns, argRuntimeConf.NetNS)
+
+	// deleting after stopping is fine
+	err = podNet.WhenDeleted(ctx, &Properties{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fake.DelNetworkListCallCount())
+}
+
 func Test_cniPodNetwork_ips_Simple(t *testing.T) {
 	t.Parallel()
 
@@ -335,31 +441,66 @@ func testCNIContNet(t *testing.T) (*cniContainerNetwork, *libcnifake.FakeCNI, st
 	}, fake, tmpDir
 }
 
-func Test_cniContainerNetwork_WhenStarted(t *testing.T) {
+func Test_cniContainerNetwork_Noop(t *testing.T) {
 	t.Parallel()
 
 	contNet, fake, tmpDir := testCNIContNet(t)
 	defer os.RemoveAll(tmpDir)
 
-	fake.AddNetworkListReturns(&current.Result{CNIVersion: "1.0.0", IPs: []*current.IPConfig{}}, nil)
-
+	// the containers join the network namespace of the pod
 	res, err := contNet.WhenStarted(ctx, &PropertiesRunning{Properties: Properties{}, Pid: 6})
 	assert.NoError(t, err)
-	assert.Equal(t, 1, fake.AddNetworkListCallCount())
-	assert.NotEmpty(t, res.Data)
-	assert.Empty(t, res.Nics)
-	assert.Empty(t, res.NetworkConfigEntries)
+	assert.Nil(t, res)
+
+	err = contNet.WhenDeleted(ctx, &Properties{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, fake.AddNetworkListCallCount())
+	assert.Equal(t, 0, fake.DelNetworkListCallCount())
 }
 
-func Test_cniContainerNetwork_WhenDeleted(t *testing.T) {
+func Test_cniPlugin_Leftovers(t *testing.T) {
 	t.Parallel()
 
-	contNet, fake, tmpDir := testCNIContNet(t)
+	plugin, fake, tmpDir := testCNIPlugin(t)
 	defer os.RemoveAll(tmpDir)
 
-	fake.DelNetworkListReturns(nil)
+	for _, name := range []string{"alive", "gone", "foreign"} {
+		err := plugin.createNetns(filepath.Join(plugin.conf.NetnsPath, name))
+		assert.NoError(t, err)
+	}
+
+	// only pods of lxe have a cached result with the name of their network namespace
+	fake.GetNetworkListCachedResultStub = func(_ *libcni.NetworkConfigList, rt *libcni.RuntimeConf) (types.Result, error) {
+		if rt.ContainerID == "foreign" {
+			return nil, nil
+		}
 
-	err := contNet.WhenDeleted(ctx, &Properties{})
+		return &current.Result{}, nil
+	}
+
+	leftovers, err := plugin.Leftovers(ctx, map[string]bool{"alive": true})
+	assert.NoError(t, err)
+	assert.Len(t, leftovers, 1)
+	assert.Equal(t, "gone", leftovers[0].Name)
+	assert.True(t, leftovers[0].Removable)
+
+	err = plugin.RemoveLeftover(ctx, leftovers[0])
 	assert.NoError(t, err)
 	assert.Equal(t, 1, fake.DelNetworkListCallCount())
+	assert.NoFileExists(t, filepath.Join(plugin.conf.NetnsPath, "gone"))
+	assert.FileExists(t, filepath.Join(plugin.conf.NetnsPath, "foreign"))
+}
+
+func Test_cniPlugin_Leftovers_NoNetnsPath(t *testing.T) {
+	t.Parallel()
+
+	plugin, _, tmpDir := testCNIPlugin(t)
+	defer os.RemoveAll(tmpDir)
+
+	plugin.conf.NetnsPath = filepath.Join(tmpDir, "missing")
+
+	leftovers, err := plugin.Leftovers(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, leftovers)
 }
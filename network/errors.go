@@ -6,4 +6,5 @@ import (
 
 var (
 	ErrNotSupported = errors.New("not supported")
+	ErrNetnsLost    = errors.New("network namespace lost")
 )
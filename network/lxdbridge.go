@@ -40,12 +40,12 @@ func (c *ConfLXDBridge) setDefaults() {
 // lxdBridgePlugin manages the pod networks using LXDBridge
 type lxdBridgePlugin struct {
 	noopPlugin // every method not implemented is noop
-	server     lxd.ContainerServer
+	server     lxd.InstanceServer
 	conf       ConfLXDBridge
 }
 
 // InitPluginLXDBridge instantiates the LXDBridge plugin using the provided config
-func InitPluginLXDBridge(server lxd.ContainerServer, conf ConfLXDBridge) (*lxdBridgePlugin, error) { // nolint: golint, revive // intended to not export lxdBridgePlugin
+func InitPluginLXDBridge(server lxd.InstanceServer, conf ConfLXDBridge) (*lxdBridgePlugin, error) { // nolint: golint, revive // intended to not export lxdBridgePlugin
 	conf.setDefaults()
 
 	p := &lxdBridgePlugin{
@@ -252,3 +252,42 @@ type lxdBridgeContainerNetwork struct {
 	cid                  string
 	annotations          map[string]string
 }
+
+// leftoverLease is the kind of a DHCP lease of a container which doesn't exist anymore
+const leftoverLease = "lease"
+
+// Leftovers returns the dynamic DHCP leases of the bridge whose container doesn't exist anymore. They can't be
+// removed, they are only released when they expire.
+func (p *lxdBridgePlugin) Leftovers(_ context.Context, _ map[string]bool) ([]Leftover, error) {
+	leases, err := p.server.GetNetworkLeases(p.conf.LXDBridge)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := p.server.GetInstancesAllProjects(api.InstanceTypeAny)
+	if err != nil {
+		return nil, err
+	}
+
+	exists := map[string]bool{}
+	for _, i := range instances {
+		exists[i.Name] = true
+	}
+
+	leftovers := []Leftover{}
+
+	for _, l := range leases {
+		if l.Type != "dynamic" || l.Hostname == "" || exists[l.Hostname] {
+			continue
+		}
+
+		leftovers = append(leftovers, Leftover{Kind: leftoverLease, Name: fmt.Sprintf("%s %s", l.Address, l.Hostname)})
+	}
+
+	return leftovers, nil
+}
+
+// RemoveLeftover isn't supported, leases can't be removed using LXD
+func (p *lxdBridgePlugin) RemoveLeftover(_ context.Context, leftover Leftover) error {
+	return fmt.Errorf("%w: leftover %s", ErrNotSupported, leftover.Kind)
+}
@@ -17,13 +17,14 @@ const (
 
 var (
 	// verify interface satisfaction
-	_ Plugin           = &lxdBridgePlugin{}
-	_ PodNetwork       = &lxdBridgePodNetwork{}
-	_ ContainerNetwork = &lxdBridgeContainerNetwork{}
+	_ Plugin            = &lxdBridgePlugin{}
+	_ PodNetwork        = &lxdBridgePodNetwork{}
+	_ ContainerNetwork  = &lxdBridgeContainerNetwork{}
+	_ LeftoverCollector = &lxdBridgePlugin{}
 )
 
-func testLXDClient() (lxd.ContainerServer, *lxdfakes.FakeContainerServer) {
-	fake := &lxdfakes.FakeContainerServer{}
+func testLXDClient() (lxd.ContainerServer, *lxdfakes.FakeInstanceServer) {
+	fake := &lxdfakes.FakeInstanceServer{}
 
 	return fake, fake
 }
@@ -80,7 +81,7 @@ func TestInitPluginLXDBridge_DefinedAndUpdate(t *testing.T) {
 	assert.Equal(t, "port=0", args.Config["raw.dnsmasq"])
 }
 
-func testLXDBridgePlugin() (*lxdBridgePlugin, *lxdfakes.FakeContainerServer) {
+func testLXDBridgePlugin() (*lxdBridgePlugin, *lxdfakes.FakeInstanceServer) {
 	client, fake := testLXDClient()
 
 	return &lxdBridgePlugin{
@@ -248,7 +249,7 @@ func Test_lxdBridgePlugin_findFreeIP_NoRangeSupportYet(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func testLXDBridgePodNetwork() (*lxdBridgePodNetwork, *lxdfakes.FakeContainerServer) {
+func testLXDBridgePodNetwork() (*lxdBridgePodNetwork, *lxdfakes.FakeInstanceServer) {
 	plugin, fake := testLXDBridgePlugin()
 
 	return &lxdBridgePodNetwork{
@@ -321,3 +322,24 @@ func Test_lxdBridgePodNetwork_WhenCreated_Simple(t *testing.T) {
 	assert.NotEmpty(t, res.Data["interface-address"])
 	assert.NotEmpty(t, res.Nics[0].IPv4Address)
 }
+
+func Test_lxdBridgePlugin_Leftovers(t *testing.T) {
+	t.Parallel()
+
+	plugin, fake := testLXDBridgePlugin()
+
+	fake.GetNetworkLeasesReturns([]api.NetworkLease{
+		{Address: "192.168.224.2", Hostname: "alive", Type: "dynamic"},
+		{Address: "192.168.224.3", Hostname: "gone", Type: "dynamic"},
+		{Address: "192.168.224.4", Hostname: "static", Type: "static"},
+		{Address: "192.168.224.5", Type: "dynamic"},
+	}, nil)
+	fake.GetInstancesAllProjectsReturns([]api.Instance{{Name: "alive"}}, nil)
+
+	leftovers, err := plugin.Leftovers(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Leftover{{Kind: leftoverLease, Name: "192.168.224.3 gone"}}, leftovers)
+
+	err = plugin.RemoveLeftover(ctx, leftovers[0])
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
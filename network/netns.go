@@ -0,0 +1,108 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"golang.org/x/sys/unix"
+)
+
+// createNetns creates a new network namespace and keeps it by bind mounting it to path, like `ip netns add`. An
+// existing namespace at path is kept.
+func createNetns(path string) (err error) {
+	if IsNetns(path) {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755) // nolint: gomnd
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE|os.O_EXCL, 0444) // nolint: gomnd
+	if err != nil && !errors.Is(err, os.ErrExist) {
+		return err
+	}
+
+	if f != nil {
+		f.Close()
+	}
+
+	defer func() {
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	errCh := make(chan error, 1)
+
+	// the namespace is created in a thread of its own, which is thrown away afterwards as it can't reliably return to
+	// the namespace of the process
+	go func() {
+		runtime.LockOSThread()
+
+		err := unix.Unshare(unix.CLONE_NEWNET)
+		if err != nil {
+			errCh <- fmt.Errorf("unable to create network namespace: %w", err)
+
+			return
+		}
+
+		threadNs := fmt.Sprintf("/proc/%d/task/%d/ns/net", os.Getpid(), unix.Gettid())
+
+		err = unix.Mount(threadNs, path, "none", unix.MS_BIND, "")
+		if err != nil {
+			err = fmt.Errorf("unable to bind mount network namespace to %s: %w", path, err)
+		}
+
+		errCh <- err
+	}()
+
+	return <-errCh
+}
+
+// removeNetns unmounts and removes the network namespace at path, a missing one is ignored
+func removeNetns(path string) error {
+	err := unix.Unmount(path, unix.MNT_DETACH)
+	if err != nil && !errors.Is(err, unix.EINVAL) && !errors.Is(err, unix.ENOENT) {
+		return fmt.Errorf("unable to unmount network namespace %s: %w", path, err)
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// IsNetns reports whether a network namespace is mounted at path
+func IsNetns(path string) bool {
+	var stat unix.Statfs_t
+
+	err := unix.Statfs(path, &stat)
+
+	return err == nil && stat.Type == unix.NSFS_MAGIC
+}
+
+// IsInNetns reports whether the process with pid is in the network namespace mounted at path
+func IsInNetns(pid int64, path string) (bool, error) {
+	procNetns, err := os.Stat(fmt.Sprintf("/proc/%d/ns/net", pid))
+	if err != nil {
+		return false, err
+	}
+
+	netns, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return os.SameFile(procNetns, netns), nil
+}
@@ -0,0 +1,85 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func Test_createNetns(t *testing.T) {
+	t.Parallel()
+
+	tmpDir, err := os.MkdirTemp("", "netns")
+	assert.NoError(t, err)
+
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "foo")
+
+	err = createNetns(path)
+	if errors.Is(err, unix.EPERM) {
+		t.Skip("creating network namespaces requires root")
+	}
+
+	assert.NoError(t, err)
+	assert.True(t, IsNetns(path))
+
+	// an existing namespace is kept
+	err = createNetns(path)
+	assert.NoError(t, err)
+
+	err = removeNetns(path)
+	assert.NoError(t, err)
+	assert.False(t, IsNetns(path))
+	assert.NoFileExists(t, path)
+
+	// a missing namespace is ignored
+	err = removeNetns(path)
+	assert.NoError(t, err)
+}
+
+func TestIsNetns(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsNetns("/proc/self/ns/net"))
+
+	tmpDir, err := os.MkdirTemp("", "netns")
+	assert.NoError(t, err)
+
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "foo")
+
+	// a file left behind after the namespace was unmounted
+	err = os.WriteFile(path, nil, 0o444)
+	assert.NoError(t, err)
+	assert.False(t, IsNetns(path))
+
+	assert.False(t, IsNetns(filepath.Join(tmpDir, "missing")))
+}
+
+func TestIsInNetns(t *testing.T) {
+	t.Parallel()
+
+	pid := int64(os.Getpid())
+
+	in, err := IsInNetns(pid, fmt.Sprintf("/proc/%d/ns/net", pid))
+	assert.NoError(t, err)
+	assert.True(t, in)
+
+	in, err = IsInNetns(pid, fmt.Sprintf("/proc/%d/ns/ipc", pid))
+	assert.NoError(t, err)
+	assert.False(t, in)
+
+	in, err = IsInNetns(pid, filepath.Join(t.TempDir(), "missing"))
+	assert.NoError(t, err)
+	assert.False(t, in)
+
+	_, err = IsInNetns(0, "/proc/self/ns/net")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/automaticserver/lxe/lxf/device"
 	"github.com/automaticserver/lxe/network/cloudinit"
@@ -77,6 +78,8 @@ type Result struct {
 	Nics []device.Nic
 	// NetworkConfigEntries of cloudinit to be set. Keep in mind cloudinit runs only when the container starts
 	NetworkConfigEntries []cloudinit.NetworkConfigEntryPhysical
+	// NetNamespace is the path of a network namespace all containers of the pod join
+	NetNamespace string
 }
 
 // Contains Status and addresses of that pod network
@@ -84,3 +87,24 @@ type Status struct {
 	// The IP of the pod network
 	IPs []net.IP
 }
+
+// LeftoverCollector is implemented by plugins which can find what's left of the network of pods which don't exist
+// anymore
+type LeftoverCollector interface {
+	// Leftovers returns the leftovers of all pods whose id isn't in pods
+	Leftovers(ctx context.Context, pods map[string]bool) ([]Leftover, error)
+	// RemoveLeftover removes a removable leftover
+	RemoveLeftover(ctx context.Context, leftover Leftover) error
+}
+
+// Leftover is what's left of the network of a pod
+type Leftover struct {
+	// Kind of the leftover, like a network namespace or a DHCP lease
+	Kind string `json:"kind" yaml:"kind"`
+	// Name of the leftover within its kind
+	Name string `json:"name" yaml:"name"`
+	// Removable is false if the plugin can only report the leftover
+	Removable bool `json:"removable" yaml:"removable"`
+	// ModifiedAt is the last change of the leftover, zero if it's unknown
+	ModifiedAt time.Time `json:"-" yaml:"-"`
+}